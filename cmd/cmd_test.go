@@ -3,6 +3,7 @@ package cmd_test
 import (
 	"bytes"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/senzing-garage/go-helpers/wraperror"
@@ -16,6 +17,8 @@ const (
 	flagAvoidServing          = "--avoid-serving"
 	flagServerCertificateFile = "--server-certificate-file"
 	flagServerKeyFile         = "--server-key-file"
+	flagTraceExporter         = "--trace-exporter"
+	flagTraceExporterFile     = "--trace-exporter-file"
 	commandName               = "command-name"
 	testServerCertificatePath = "../testdata/certificates/server/certificate.pem"
 )
@@ -86,6 +89,30 @@ func Test_RootCmd_Execute_tls(test *testing.T) {
 	require.NoError(test, err)
 }
 
+func Test_RootCmd_Execute_traceExporter_file(test *testing.T) {
+	args := []string{
+		flagAvoidServing,
+		flagTraceExporter,
+		"file",
+		flagTraceExporterFile,
+		filepath.Join(test.TempDir(), "traces.json"),
+	}
+	setArgs(cmd.RootCmd, args)
+	err := cmd.RootCmd.Execute()
+	require.NoError(test, err)
+}
+
+func Test_RootCmd_Execute_traceExporter_bad(test *testing.T) {
+	args := []string{
+		flagAvoidServing,
+		flagTraceExporter,
+		"no-such-exporter",
+	}
+	setArgs(cmd.RootCmd, args)
+	err := cmd.RootCmd.Execute()
+	require.Error(test, err)
+}
+
 func Test_CompletionCmd(test *testing.T) {
 	_ = test
 	err := cmd.CompletionCmd.Execute()
@@ -110,6 +137,30 @@ func Test_DocsAction_badDir(test *testing.T) {
 	require.Error(test, err)
 }
 
+func Test_ExportFileCmd_badFormat(test *testing.T) {
+	cmd.ExportFileCmd.PreRun(cmd.ExportFileCmd, []string{})
+
+	err := cmd.ExportFileCmd.Flags().Set("export-format", "xml")
+	require.NoError(test, err)
+
+	test.Cleanup(func() { _ = cmd.ExportFileCmd.Flags().Set("export-format", "ndjson") })
+
+	err = cmd.ExportFileCmd.RunE(cmd.ExportFileCmd, []string{})
+	require.ErrorContains(test, err, "unknown format")
+}
+
+func Test_ExportFileCmd_badPollInterval(test *testing.T) {
+	cmd.ExportFileCmd.PreRun(cmd.ExportFileCmd, []string{})
+
+	err := cmd.ExportFileCmd.Flags().Set("export-poll-interval-in-seconds", "0")
+	require.NoError(test, err)
+
+	test.Cleanup(func() { _ = cmd.ExportFileCmd.Flags().Set("export-poll-interval-in-seconds", "2") })
+
+	err = cmd.ExportFileCmd.RunE(cmd.ExportFileCmd, []string{})
+	require.ErrorContains(test, err, "SENZING_TOOLS_EXPORT_POLL_INTERVAL_IN_SECONDS must be positive")
+}
+
 // ----------------------------------------------------------------------------
 // Private functions
 // ----------------------------------------------------------------------------
@@ -0,0 +1,283 @@
+/*
+ */
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/senzing-garage/go-cmdhelping/cmdhelper"
+	"github.com/senzing-garage/go-cmdhelping/option"
+	"github.com/senzing-garage/go-cmdhelping/option/optiontype"
+	"github.com/senzing-garage/go-helpers/wraperror"
+	szadminpb "github.com/senzing-garage/serve-grpc/proto/go/szadmin"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ----------------------------------------------------------------------------
+// Context variables
+// ----------------------------------------------------------------------------
+
+var exportFileAPIKey = option.ContextVariable{
+	Arg:     "api-key",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_API_KEY", ""),
+	Envar:   "SENZING_TOOLS_API_KEY",
+	Help:    "API key sent as x-api-key when the server requires authentication. [%s]",
+	Type:    optiontype.String,
+}
+
+var exportFileCompression = option.ContextVariable{
+	Arg:     "export-compression",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_EXPORT_COMPRESSION", "none"),
+	Envar:   "SENZING_TOOLS_EXPORT_COMPRESSION",
+	Help:    "Compression of exported files: none, gzip or zstd. [%s]",
+	Type:    optiontype.String,
+}
+
+var exportFileCsvColumnList = option.ContextVariable{
+	Arg:     "export-csv-column-list",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_EXPORT_CSV_COLUMN_LIST", ""),
+	Envar:   "SENZING_TOOLS_EXPORT_CSV_COLUMN_LIST",
+	Help:    "Columns of CSV and Parquet exports. [%s]",
+	Type:    optiontype.String,
+}
+
+var exportFileFlags = option.ContextVariable{
+	Arg:     "export-flags",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_EXPORT_FLAGS", int(senzing.SzExportDefaultFlags)),
+	Envar:   "SENZING_TOOLS_EXPORT_FLAGS",
+	Help:    "Senzing export flags. [%s]",
+	Type:    optiontype.Int,
+}
+
+var exportFileFormat = option.ContextVariable{
+	Arg:     "export-format",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_EXPORT_FORMAT", "ndjson"),
+	Envar:   "SENZING_TOOLS_EXPORT_FORMAT",
+	Help:    "Format of exported files: ndjson, csv or parquet. [%s]",
+	Type:    optiontype.String,
+}
+
+var exportFileMaxFileBytes = option.ContextVariable{
+	Arg:     "export-max-file-bytes",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_EXPORT_MAX_FILE_BYTES", 0),
+	Envar:   "SENZING_TOOLS_EXPORT_MAX_FILE_BYTES",
+	Help:    "Start a new file after this many bytes. 0 writes one file. [%s]",
+	Type:    optiontype.Int,
+}
+
+var exportFileName = option.ContextVariable{
+	Arg:     "export-name",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_EXPORT_NAME", ""),
+	Envar:   "SENZING_TOOLS_EXPORT_NAME",
+	Help:    "File name prefix. Empty uses export-<job id>. [%s]",
+	Type:    optiontype.String,
+}
+
+var exportFileNoWait = option.ContextVariable{
+	Arg:     "export-no-wait",
+	Default: option.OsLookupEnvBool("SENZING_TOOLS_EXPORT_NO_WAIT", false),
+	Envar:   "SENZING_TOOLS_EXPORT_NO_WAIT",
+	Help:    "Print the job id and return without waiting for the export. [%s]",
+	Type:    optiontype.Bool,
+}
+
+var exportFilePollIntervalInSeconds = option.ContextVariable{
+	Arg: "export-poll-interval-in-seconds",
+	Default: option.OsLookupEnvInt(
+		"SENZING_TOOLS_EXPORT_POLL_INTERVAL_IN_SECONDS",
+		defaultExportFilePollIntervalInSeconds,
+	),
+	Envar: "SENZING_TOOLS_EXPORT_POLL_INTERVAL_IN_SECONDS",
+	Help:  "Seconds between checks of the export's status. Must be positive. [%s]",
+	Type:  optiontype.Int,
+}
+
+var grpcAddress = option.ContextVariable{
+	Arg:     "grpc-address",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_GRPC_ADDRESS", ""),
+	Envar:   "SENZING_TOOLS_GRPC_ADDRESS",
+	Help:    "Address of the serve-grpc server. Empty uses localhost and the gRPC port. [%s]",
+	Type:    optiontype.String,
+}
+
+var grpcCaCertificateFile = option.ContextVariable{
+	Arg:     "grpc-ca-certificate-file",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_GRPC_CA_CERTIFICATE_FILE", ""),
+	Envar:   "SENZING_TOOLS_GRPC_CA_CERTIFICATE_FILE",
+	Help:    "CA certificate of the serve-grpc server. Empty connects without TLS. [%s]",
+	Type:    optiontype.String,
+}
+
+var exportFileContextVariables = []option.ContextVariable{
+	exportFileAPIKey,
+	exportFileCompression,
+	exportFileCsvColumnList,
+	exportFileFlags,
+	exportFileFormat,
+	exportFileMaxFileBytes,
+	exportFileName,
+	exportFileNoWait,
+	exportFilePollIntervalInSeconds,
+	grpcAddress,
+	grpcCaCertificateFile,
+	option.GrpcPort,
+}
+
+// ----------------------------------------------------------------------------
+// Command
+// ----------------------------------------------------------------------------
+
+// ExportFileCmd represents the export-file command.
+var ExportFileCmd = &cobra.Command{
+	Use:   "export-file",
+	Short: "Write an entity report to files in the export directory of a running server",
+	Long: `Ask a running serve-grpc server to write an entity report to files in its
+SENZING_TOOLS_EXPORT_DIRECTORY, then wait for the export to finish and print its status.
+
+NDJSON files hold ExportJsonEntityReport rows.  CSV and Parquet files hold
+ExportCsvEntityReport rows, with one string column per CSV column in Parquet.
+`,
+	PreRun: func(cobraCommand *cobra.Command, args []string) {
+		cmdhelper.PreRun(cobraCommand, args, Use, exportFileContextVariables)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_ = args
+
+		return exportFileAction(cmd.Context(), os.Stdout)
+	},
+}
+
+const defaultExportFilePollIntervalInSeconds = 2
+
+func init() {
+	RootCmd.AddCommand(ExportFileCmd)
+	cmdhelper.Init(ExportFileCmd, exportFileContextVariables)
+}
+
+func exportFileAction(ctx context.Context, out io.Writer) error {
+	pollInterval := viper.GetInt(exportFilePollIntervalInSeconds.Arg)
+	if pollInterval <= 0 {
+		return wraperror.Errorf(
+			errPackage,
+			"%s must be positive, not %d",
+			exportFilePollIntervalInSeconds.Envar,
+			pollInterval,
+		)
+	}
+
+	request, err := buildStartFileExportRequest()
+	if err != nil {
+		return err
+	}
+
+	clientConn, err := dialExportFileServer()
+	if err != nil {
+		return err
+	}
+
+	defer clientConn.Close()
+
+	if apiKey := viper.GetString(exportFileAPIKey.Arg); len(apiKey) > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-api-key", apiKey)
+	}
+
+	adminClient := szadminpb.NewSzAdminClient(clientConn)
+
+	fileExportStatus, err := adminClient.StartFileExport(ctx, request)
+	if err != nil {
+		return wraperror.Errorf(err, "StartFileExport")
+	}
+
+	if !viper.GetBool(exportFileNoWait.Arg) {
+		for fileExportStatus.GetState() == szadminpb.FileExportState_FILE_EXPORT_STATE_RUNNING {
+			select {
+			case <-ctx.Done():
+				return wraperror.Errorf(ctx.Err(), "waiting for file export %s", fileExportStatus.GetJobId())
+			case <-time.After(time.Duration(pollInterval) * time.Second):
+			}
+
+			fileExportStatus, err = adminClient.GetFileExport(
+				ctx,
+				&szadminpb.GetFileExportRequest{JobId: fileExportStatus.GetJobId()},
+			)
+			if err != nil {
+				return wraperror.Errorf(err, "GetFileExport")
+			}
+		}
+	}
+
+	_, err = fmt.Fprintln(out, protojson.MarshalOptions{Multiline: true}.Format(fileExportStatus))
+	if err != nil {
+		return wraperror.Errorf(err, "printing status")
+	}
+
+	switch fileExportStatus.GetState() {
+	case szadminpb.FileExportState_FILE_EXPORT_STATE_FAILED, szadminpb.FileExportState_FILE_EXPORT_STATE_CANCELED:
+		return wraperror.Errorf(errPackage, "file export %s ended %s: %s",
+			fileExportStatus.GetJobId(), fileExportStatus.GetState(), fileExportStatus.GetError())
+	default:
+		return nil
+	}
+}
+
+func buildStartFileExportRequest() (*szadminpb.StartFileExportRequest, error) {
+	format := viper.GetString(exportFileFormat.Arg)
+	compression := viper.GetString(exportFileCompression.Arg)
+
+	formatValue, isKnown := szadminpb.FileExportFormat_value["FILE_EXPORT_FORMAT_"+strings.ToUpper(format)]
+	if !isKnown || formatValue == 0 {
+		return nil, wraperror.Errorf(errPackage, "unknown format %q", format)
+	}
+
+	compressionValue, isKnown := szadminpb.FileExportCompression_value["FILE_EXPORT_COMPRESSION_"+strings.ToUpper(compression)]
+	if !isKnown {
+		return nil, wraperror.Errorf(errPackage, "unknown compression %q", compression)
+	}
+
+	return &szadminpb.StartFileExportRequest{
+		Format:        szadminpb.FileExportFormat(formatValue),
+		Compression:   szadminpb.FileExportCompression(compressionValue),
+		CsvColumnList: viper.GetString(exportFileCsvColumnList.Arg),
+		Flags:         viper.GetInt64(exportFileFlags.Arg),
+		MaxFileBytes:  viper.GetInt64(exportFileMaxFileBytes.Arg),
+		Name:          viper.GetString(exportFileName.Arg),
+	}, nil
+}
+
+func dialExportFileServer() (*grpc.ClientConn, error) {
+	address := viper.GetString(grpcAddress.Arg)
+	if len(address) == 0 {
+		address = net.JoinHostPort("localhost", strconv.Itoa(viper.GetInt(option.GrpcPort.Arg)))
+	}
+
+	caCertificateFile := viper.GetString(grpcCaCertificateFile.Arg)
+
+	transportCredentials := insecure.NewCredentials()
+
+	if len(caCertificateFile) > 0 {
+		var err error
+
+		transportCredentials, err = credentials.NewClientTLSFromFile(caCertificateFile, "")
+		if err != nil {
+			return nil, wraperror.Errorf(err, "NewClientTLSFromFile")
+		}
+	}
+
+	clientConn, err := grpc.NewClient(address, grpc.WithTransportCredentials(transportCredentials))
+
+	return clientConn, wraperror.Errorf(err, "NewClient")
+}
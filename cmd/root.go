@@ -4,23 +4,24 @@ package cmd
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
+	"errors"
 	"math"
 	"os"
-	"sync"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/senzing-garage/go-cmdhelping/cmdhelper"
 	"github.com/senzing-garage/go-cmdhelping/option"
 	"github.com/senzing-garage/go-cmdhelping/option/optiontype"
 	"github.com/senzing-garage/go-cmdhelping/settings"
-	tlshelper "github.com/senzing-garage/go-helpers/tls"
 	"github.com/senzing-garage/go-helpers/wraperror"
 	"github.com/senzing-garage/serve-grpc/grpcserver"
 	"github.com/senzing-garage/serve-grpc/httpserver"
+	"github.com/senzing-garage/serve-grpc/tlsreload"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
@@ -52,7 +53,12 @@ const (
 	defaultMaxHeaderListSizeInBytes                               = uint32(16 << 20)
 	defaultMaxReceiveMessageSizeInBytes                           = math.MaxInt32
 	defaultMaxSendMessageSizeInBytes                              = math.MaxInt32
+	defaultCertificateReloadIntervalInSeconds                     = 10
+	defaultHealthCheckIntervalInSeconds                           = 10
 	defaultReadBufferSizeInBytes                                  = 32 * 1024
+	defaultRedoIdleBackoffInSeconds                               = 1
+	defaultRedoMaxIdleBackoffInSeconds                            = 30
+	defaultShutdownTimeoutInSeconds                               = 30
 	defaultWriteBufferSizeInBytes                                 = 32 * 1024
 )
 
@@ -60,6 +66,97 @@ const (
 // Context variables
 // ----------------------------------------------------------------------------
 
+var authAPIKeysFile = option.ContextVariable{
+	Arg:     "auth-api-keys-file",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_AUTH_API_KEYS_FILE", ""),
+	Envar:   "SENZING_TOOLS_AUTH_API_KEYS_FILE",
+	Help:    "Path to a JSON file of API keys accepted in the x-api-key header. [%s]",
+	Type:    optiontype.String,
+}
+
+var authJwksFile = option.ContextVariable{
+	Arg:     "auth-jwks-file",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_AUTH_JWKS_FILE", ""),
+	Envar:   "SENZING_TOOLS_AUTH_JWKS_FILE",
+	Help:    "Path to a JWKS file of keys that sign JWTs accepted in the authorization header. [%s]",
+	Type:    optiontype.String,
+}
+
+var authJwtAudience = option.ContextVariable{
+	Arg:     "auth-jwt-audience",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_AUTH_JWT_AUDIENCE", ""),
+	Envar:   "SENZING_TOOLS_AUTH_JWT_AUDIENCE",
+	Help:    "If set, JWTs must list this audience in their aud claim. [%s]",
+	Type:    optiontype.String,
+}
+
+var authJwtIssuer = option.ContextVariable{
+	Arg:     "auth-jwt-issuer",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_AUTH_JWT_ISSUER", ""),
+	Envar:   "SENZING_TOOLS_AUTH_JWT_ISSUER",
+	Help:    "If set, JWTs must have this iss claim. [%s]",
+	Type:    optiontype.String,
+}
+
+var authMtls = option.ContextVariable{
+	Arg:     "auth-mtls",
+	Default: option.OsLookupEnvBool("SENZING_TOOLS_AUTH_MTLS", false),
+	Envar:   "SENZING_TOOLS_AUTH_MTLS",
+	Help:    "Authenticate callers by the subject of their verified client certificate. [%s]",
+	Type:    optiontype.Bool,
+}
+
+var authPolicyFile = option.ContextVariable{
+	Arg:     "auth-policy-file",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_AUTH_POLICY_FILE", ""),
+	Envar:   "SENZING_TOOLS_AUTH_POLICY_FILE",
+	Help:    "Path to a JSON file of rules deciding which roles may call which methods and data sources. [%s]",
+	Type:    optiontype.String,
+}
+
+var batchMaxItems = option.ContextVariable{
+	Arg:     "batch-max-items",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_BATCH_MAX_ITEMS", 0),
+	Envar:   "SENZING_TOOLS_BATCH_MAX_ITEMS",
+	Help:    "Items allowed in one SzBatch call. 0 uses 1000. [%s]",
+	Type:    optiontype.Int,
+}
+
+var batchWorkers = option.ContextVariable{
+	Arg:     "batch-workers",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_BATCH_WORKERS", 0),
+	Envar:   "SENZING_TOOLS_BATCH_WORKERS",
+	Help:    "Items each SzBatch call reads at the same time. 0 uses 8. [%s]",
+	Type:    optiontype.Int,
+}
+
+var bulkProgressInterval = option.ContextVariable{
+	Arg:     "bulk-progress-interval",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_BULK_PROGRESS_INTERVAL", 0),
+	Envar:   "SENZING_TOOLS_BULK_PROGRESS_INTERVAL",
+	Help:    "Items between progress messages on SzBulk delete and reevaluate streams. 0 uses 1000. [%s]",
+	Type:    optiontype.Int,
+}
+
+var bulkWorkers = option.ContextVariable{
+	Arg:     "bulk-workers",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_BULK_WORKERS", 0),
+	Envar:   "SENZING_TOOLS_BULK_WORKERS",
+	Help:    "Records each SzBulk stream adds at the same time. 0 uses the number of CPUs. [%s]",
+	Type:    optiontype.Int,
+}
+
+var certificateReloadIntervalInSeconds = option.ContextVariable{
+	Arg: "certificate-reload-interval-in-seconds",
+	Default: option.OsLookupEnvInt(
+		"SENZING_TOOLS_CERTIFICATE_RELOAD_INTERVAL_IN_SECONDS",
+		defaultCertificateReloadIntervalInSeconds,
+	),
+	Envar: "SENZING_TOOLS_CERTIFICATE_RELOAD_INTERVAL_IN_SECONDS",
+	Help:  "Seconds between checks for changed TLS certificate and key files. 0 disables reloading. [%s]",
+	Type:  optiontype.Int,
+}
+
 var clientCaCertificateFile = option.ContextVariable{
 	Arg:     "client-ca-certificate-file",
 	Default: option.OsLookupEnvString("SENZING_TOOLS_CLIENT_CA_CERTIFICATE_FILE", ""),
@@ -76,6 +173,22 @@ var clientCaCertificateFiless = option.ContextVariable{
 	Type:    optiontype.StringSlice,
 }
 
+var corsAllowedOrigins = option.ContextVariable{
+	Arg:     "cors-allowed-origins",
+	Default: []string{},
+	Envar:   "SENZING_TOOLS_CORS_ALLOWED_ORIGINS",
+	Help:    "Origins, such as https://app.example.com or https://*.example.com, allowed to call the HTTP server from a browser. [%s]",
+	Type:    optiontype.StringSlice,
+}
+
+var corsPolicyFile = option.ContextVariable{
+	Arg:     "cors-policy-file",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_CORS_POLICY_FILE", ""),
+	Envar:   "SENZING_TOOLS_CORS_POLICY_FILE",
+	Help:    "Path to a JSON file of the origins, headers, credentials and max-age allowed for browser calls to the HTTP server, with per-route overrides. [%s]",
+	Type:    optiontype.String,
+}
+
 var enableHTTP = option.ContextVariable{
 	Arg:     "enable-http",
 	Default: option.OsLookupEnvBool("SENZING_TOOLS_ENABLE_HTTP", false),
@@ -84,6 +197,89 @@ var enableHTTP = option.ContextVariable{
 	Type:    optiontype.Bool,
 }
 
+var enableNotifications = option.ContextVariable{
+	Arg:     "enable-notifications",
+	Default: option.OsLookupEnvBool("SENZING_TOOLS_ENABLE_NOTIFICATIONS", false),
+	Envar:   "SENZING_TOOLS_ENABLE_NOTIFICATIONS",
+	Help:    "Enable the live feed of observer notifications in szadmin.SzAdmin/WatchNotifications and at /ws/notifications [%s]",
+	Type:    optiontype.Bool,
+}
+
+var enableREST = option.ContextVariable{
+	Arg:     "enable-rest",
+	Default: option.OsLookupEnvBool("SENZING_TOOLS_ENABLE_REST", false),
+	Envar:   "SENZING_TOOLS_ENABLE_REST",
+	Help:    "Enable the REST gateway to the Senzing services at /api/v1 on the HTTP port [%s]",
+	Type:    optiontype.Bool,
+}
+
+var enableWebSocket = option.ContextVariable{
+	Arg:     "enable-websocket",
+	Default: option.OsLookupEnvBool("SENZING_TOOLS_ENABLE_WEBSOCKET", false),
+	Envar:   "SENZING_TOOLS_ENABLE_WEBSOCKET",
+	Help:    "Enable WebSocket streams of entity exports and observer notifications at /ws on the HTTP port [%s]",
+	Type:    optiontype.Bool,
+}
+
+var exportCheckpointInterval = option.ContextVariable{
+	Arg:     "export-checkpoint-interval",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_EXPORT_CHECKPOINT_INTERVAL", 0),
+	Envar:   "SENZING_TOOLS_EXPORT_CHECKPOINT_INTERVAL",
+	Help:    "Rows between resume tokens on szexport.SzExport streams that do not ask for an interval. 0 uses 1000. [%s]",
+	Type:    optiontype.Int,
+}
+
+var exportDirectory = option.ContextVariable{
+	Arg:     "export-directory",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_EXPORT_DIRECTORY", ""),
+	Envar:   "SENZING_TOOLS_EXPORT_DIRECTORY",
+	Help:    "Directory that szadmin.SzAdmin StartFileExport writes to. Empty disables file exports. [%s]",
+	Type:    optiontype.String,
+}
+
+var exportIdleTTLInSeconds = option.ContextVariable{
+	Arg:     "export-idle-ttl-in-seconds",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_EXPORT_IDLE_TTL_IN_SECONDS", 0),
+	Envar:   "SENZING_TOOLS_EXPORT_IDLE_TTL_IN_SECONDS",
+	Help:    "Seconds an export opened with SzEngine may go without FetchNext before it is closed. 0 uses 10 minutes. [%s]",
+	Type:    optiontype.Int,
+}
+
+var exportResumeTokenKey = option.ContextVariable{
+	Arg:     "export-resume-token-key",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_EXPORT_RESUME_TOKEN_KEY", ""),
+	Envar:   "SENZING_TOOLS_EXPORT_RESUME_TOKEN_KEY",
+	Help:    "Secret signing export resume tokens. Share it so replicas accept each other's tokens. Empty uses a random key. [%s]",
+	Type:    optiontype.String,
+}
+
+var exportResumeTokenTTLInSeconds = option.ContextVariable{
+	Arg:     "export-resume-token-ttl-in-seconds",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_EXPORT_RESUME_TOKEN_TTL_IN_SECONDS", 0),
+	Envar:   "SENZING_TOOLS_EXPORT_RESUME_TOKEN_TTL_IN_SECONDS",
+	Help:    "Seconds an export resume token can be used. 0 uses one hour. [%s]",
+	Type:    optiontype.Int,
+}
+
+var healthCheckIntervalInSeconds = option.ContextVariable{
+	Arg: "health-check-interval-in-seconds",
+	Default: option.OsLookupEnvInt(
+		"SENZING_TOOLS_HEALTH_CHECK_INTERVAL_IN_SECONDS",
+		defaultHealthCheckIntervalInSeconds,
+	),
+	Envar: "SENZING_TOOLS_HEALTH_CHECK_INTERVAL_IN_SECONDS",
+	Help:  "Seconds between Senzing SDK probes reported by grpc.health.v1.Health. [%s]",
+	Type:  optiontype.Int,
+}
+
+var jobStateFile = option.ContextVariable{
+	Arg:     "job-state-file",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_JOB_STATE_FILE", ""),
+	Envar:   "SENZING_TOOLS_JOB_STATE_FILE",
+	Help:    "JSON file in which szjob.SzJob jobs are kept across restarts. Empty keeps jobs in memory. [%s]",
+	Type:    optiontype.String,
+}
+
 var keepaliveEnforcementPolicyMinTimeInSeconds = option.ContextVariable{
 	Arg: "keepalive-enforcement-policy-min-time-in-seconds",
 	Default: option.OsLookupEnvInt(
@@ -172,6 +368,14 @@ var maxConcurrentStreams = option.ContextVariable{
 	Type:  optiontype.Uint32,
 }
 
+var maxFinishedJobs = option.ContextVariable{
+	Arg:     "max-finished-jobs",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_MAX_FINISHED_JOBS", 0),
+	Envar:   "SENZING_TOOLS_MAX_FINISHED_JOBS",
+	Help:    "Finished szjob.SzJob jobs remembered. 0 uses 1000. [%s]",
+	Type:    optiontype.Int,
+}
+
 var maxHeaderListSizeInBytes = option.ContextVariable{
 	Arg: "max-header-list-size-in-bytes",
 	Default: option.OsLookupEnvUint32(
@@ -194,6 +398,14 @@ var maxReceiveMessageSizeInBytes = option.ContextVariable{
 	Type:  optiontype.Int,
 }
 
+var maxRunningJobs = option.ContextVariable{
+	Arg:     "max-running-jobs",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_MAX_RUNNING_JOBS", 0),
+	Envar:   "SENZING_TOOLS_MAX_RUNNING_JOBS",
+	Help:    "szjob.SzJob jobs run at the same time; later jobs wait. 0 uses 2. [%s]",
+	Type:    optiontype.Int,
+}
+
 var maxSendMessageSizeInBytes = option.ContextVariable{
 	Arg: "max-send-message-size-in-bytes",
 	Default: option.OsLookupEnvInt(
@@ -205,6 +417,22 @@ var maxSendMessageSizeInBytes = option.ContextVariable{
 	Type:  optiontype.Int,
 }
 
+var metricsPath = option.ContextVariable{
+	Arg:     "metrics-path",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_METRICS_PATH", "/metrics"),
+	Envar:   "SENZING_TOOLS_METRICS_PATH",
+	Help:    "Path on the HTTP server where gRPC metrics are served in Prometheus format. Empty disables. [%s]",
+	Type:    optiontype.String,
+}
+
+var rateLimitsFile = option.ContextVariable{
+	Arg:     "rate-limits-file",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_RATE_LIMITS_FILE", ""),
+	Envar:   "SENZING_TOOLS_RATE_LIMITS_FILE",
+	Help:    "Path to a JSON file of per-client rate and in-flight limits for read, write and analytics calls. [%s]",
+	Type:    optiontype.String,
+}
+
 var readBufferSizeInBytes = option.ContextVariable{
 	Arg: "read-buffer-size-in-bytes",
 	Default: option.OsLookupEnvInt(
@@ -216,6 +444,49 @@ var readBufferSizeInBytes = option.ContextVariable{
 	Type:  optiontype.Int,
 }
 
+var readOnly = option.ContextVariable{
+	Arg:     "read-only",
+	Default: option.OsLookupEnvBool("SENZING_TOOLS_READ_ONLY", false),
+	Envar:   "SENZING_TOOLS_READ_ONLY",
+	Help:    "Refuse calls that change the Senzing repository or its configuration. [%s]",
+	Type:    optiontype.Bool,
+}
+
+var redoIdleBackoffInSeconds = option.ContextVariable{
+	Arg:     "redo-idle-backoff-in-seconds",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_REDO_IDLE_BACKOFF_IN_SECONDS", defaultRedoIdleBackoffInSeconds),
+	Envar:   "SENZING_TOOLS_REDO_IDLE_BACKOFF_IN_SECONDS",
+	Help:    "Seconds a redo worker waits after finding the redo queue empty. Doubles while the queue stays empty. [%s]",
+	Type:    optiontype.Int,
+}
+
+var redoMaxIdleBackoffInSeconds = option.ContextVariable{
+	Arg: "redo-max-idle-backoff-in-seconds",
+	Default: option.OsLookupEnvInt(
+		"SENZING_TOOLS_REDO_MAX_IDLE_BACKOFF_IN_SECONDS",
+		defaultRedoMaxIdleBackoffInSeconds,
+	),
+	Envar: "SENZING_TOOLS_REDO_MAX_IDLE_BACKOFF_IN_SECONDS",
+	Help:  "Longest wait of a redo worker while the redo queue stays empty. [%s]",
+	Type:  optiontype.Int,
+}
+
+var redoMaxPerSecond = option.ContextVariable{
+	Arg:     "redo-max-per-second",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_REDO_MAX_PER_SECOND", 0),
+	Envar:   "SENZING_TOOLS_REDO_MAX_PER_SECOND",
+	Help:    "Most redo records processed per second by all redo workers together. 0 means no limit. [%s]",
+	Type:    optiontype.Int,
+}
+
+var redoWorkers = option.ContextVariable{
+	Arg:     "redo-workers",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_REDO_WORKERS", 0),
+	Envar:   "SENZING_TOOLS_REDO_WORKERS",
+	Help:    "Goroutines draining the Senzing redo queue in the background. 0 disables redo processing. [%s]",
+	Type:    optiontype.Int,
+}
+
 var serverCertificateFile = option.ContextVariable{
 	Arg:     "server-certificate-file",
 	Default: option.OsLookupEnvString("SENZING_TOOLS_SERVER_CERTIFICATE_FILE", ""),
@@ -240,6 +511,65 @@ var serverKeyPassPhrase = option.ContextVariable{
 	Type:    optiontype.String,
 }
 
+var shutdownTimeoutInSeconds = option.ContextVariable{
+	Arg: "shutdown-timeout-in-seconds",
+	Default: option.OsLookupEnvInt(
+		"SENZING_TOOLS_SHUTDOWN_TIMEOUT_IN_SECONDS",
+		defaultShutdownTimeoutInSeconds,
+	),
+	Envar: "SENZING_TOOLS_SHUTDOWN_TIMEOUT_IN_SECONDS",
+	Help:  "Seconds to wait for in-flight calls to finish on SIGTERM/SIGINT before cancelling them. [%s]",
+	Type:  optiontype.Int,
+}
+
+var singlePort = option.ContextVariable{
+	Arg:     "single-port",
+	Default: option.OsLookupEnvBool("SENZING_TOOLS_SINGLE_PORT", false),
+	Envar:   "SENZING_TOOLS_SINGLE_PORT",
+	Help:    "Serve gRPC, grpc-web, REST and metrics on the HTTP port only; the gRPC port is not opened. [%s]",
+	Type:    optiontype.Bool,
+}
+
+var timeoutsFile = option.ContextVariable{
+	Arg:     "timeouts-file",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_TIMEOUTS_FILE", ""),
+	Envar:   "SENZING_TOOLS_TIMEOUTS_FILE",
+	Help:    "Path to a JSON file of per-method default and maximum call durations. [%s]",
+	Type:    optiontype.String,
+}
+
+var traceExporter = option.ContextVariable{
+	Arg:     "trace-exporter",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_TRACE_EXPORTER", traceExporterValueNone),
+	Envar:   "SENZING_TOOLS_TRACE_EXPORTER",
+	Help:    "OpenTelemetry trace exporter: none, otlp, stdout or file. [%s]",
+	Type:    optiontype.String,
+}
+
+var traceExporterFile = option.ContextVariable{
+	Arg:     "trace-exporter-file",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_TRACE_EXPORTER_FILE", ""),
+	Envar:   "SENZING_TOOLS_TRACE_EXPORTER_FILE",
+	Help:    "Path of the file spans are appended to when SENZING_TOOLS_TRACE_EXPORTER is file. [%s]",
+	Type:    optiontype.String,
+}
+
+var traceExporterOtlpEndpoint = option.ContextVariable{
+	Arg:     "trace-exporter-otlp-endpoint",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_TRACE_EXPORTER_OTLP_ENDPOINT", ""),
+	Envar:   "SENZING_TOOLS_TRACE_EXPORTER_OTLP_ENDPOINT",
+	Help:    "host:port of the OTLP/gRPC collector. Empty uses OTEL_EXPORTER_OTLP_ENDPOINT or localhost:4317. [%s]",
+	Type:    optiontype.String,
+}
+
+var traceExporterOtlpInsecure = option.ContextVariable{
+	Arg:     "trace-exporter-otlp-insecure",
+	Default: option.OsLookupEnvBool("SENZING_TOOLS_TRACE_EXPORTER_OTLP_INSECURE", false),
+	Envar:   "SENZING_TOOLS_TRACE_EXPORTER_OTLP_INSECURE",
+	Help:    "Connect to the OTLP collector without TLS. [%s]",
+	Type:    optiontype.Bool,
+}
+
 var writeBufferSizeInBytes = option.ContextVariable{
 	Arg: "write-buffer-size-in-bytes",
 	Default: option.OsLookupEnvInt(
@@ -252,9 +582,32 @@ var writeBufferSizeInBytes = option.ContextVariable{
 }
 
 var ContextVariablesForMultiPlatform = []option.ContextVariable{
+	authAPIKeysFile,
+	authJwksFile,
+	authJwtAudience,
+	authJwtIssuer,
+	authMtls,
+	authPolicyFile,
+	batchMaxItems,
+	batchWorkers,
+	bulkProgressInterval,
+	bulkWorkers,
+	certificateReloadIntervalInSeconds,
 	clientCaCertificateFile,
 	clientCaCertificateFiless,
+	corsAllowedOrigins,
+	corsPolicyFile,
 	enableHTTP,
+	enableNotifications,
+	enableREST,
+	enableWebSocket,
+	exportCheckpointInterval,
+	exportDirectory,
+	exportIdleTTLInSeconds,
+	exportResumeTokenKey,
+	exportResumeTokenTTLInSeconds,
+	healthCheckIntervalInSeconds,
+	jobStateFile,
 	keepaliveEnforcementPolicyMinTimeInSeconds,
 	keepaliveEnforcementPolicyPermitWithoutStream,
 	keepaliveServerParameterMaxConnectionAgeGraceInSeconds,
@@ -263,9 +616,12 @@ var ContextVariablesForMultiPlatform = []option.ContextVariable{
 	keepaliveServerParameterTimeInSeconds,
 	keepaliveServerParameterTimeoutInSeconds,
 	maxConcurrentStreams,
+	maxFinishedJobs,
 	maxHeaderListSizeInBytes,
 	maxReceiveMessageSizeInBytes,
+	maxRunningJobs,
 	maxSendMessageSizeInBytes,
+	metricsPath,
 	option.AvoidServe,
 	option.BindAddress,
 	option.CoreInstanceName,
@@ -285,10 +641,23 @@ var ContextVariablesForMultiPlatform = []option.ContextVariable{
 	option.ObserverOrigin,
 	option.ObserverURL,
 	option.ServerAddress,
+	rateLimitsFile,
 	readBufferSizeInBytes,
+	readOnly,
+	redoIdleBackoffInSeconds,
+	redoMaxIdleBackoffInSeconds,
+	redoMaxPerSecond,
+	redoWorkers,
 	serverCertificateFile,
 	serverKeyFile,
 	serverKeyPassPhrase,
+	shutdownTimeoutInSeconds,
+	singlePort,
+	timeoutsFile,
+	traceExporter,
+	traceExporterFile,
+	traceExporterOtlpEndpoint,
+	traceExporterOtlpInsecure,
 	writeBufferSizeInBytes,
 }
 
@@ -330,11 +699,35 @@ func PreRun(cobraCommand *cobra.Command, args []string) {
 func RunE(_ *cobra.Command, _ []string) error {
 	var err error
 
-	ctx := context.Background()
+	// Stop serving on SIGINT or SIGTERM.
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Start exporting OpenTelemetry spans.
+
+	tracerProvider, shutdownTracerProvider, err := buildTracerProvider(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "buildTracerProvider")
+	}
+
+	defer func() { _ = shutdownTracerProvider(context.WithoutCancel(ctx)) }()
+
+	// Load the TLS certificates, shared by the gRPC and HTTP servers, and follow changes to their files.
+
+	tlsReloader, err := buildTLSReloader(ctx)
+	if err != nil {
+		return wraperror.Errorf(err, "buildTLSReloader")
+	}
+
+	reloadInterval := time.Duration(viper.GetInt(certificateReloadIntervalInSeconds.Arg)) * time.Second
+	if tlsReloader != nil && reloadInterval > 0 {
+		go tlsReloader.Watch(ctx, reloadInterval)
+	}
 
 	// Create and Initialize gRPC Server.
 
-	grpcserver, err := buildBasicGrpcServer(ctx)
+	grpcserver, err := buildBasicGrpcServer(ctx, tracerProvider, tlsReloader)
 	if err != nil {
 		return wraperror.Errorf(err, "buildBasicGrpcServer")
 	}
@@ -346,7 +739,7 @@ func RunE(_ *cobra.Command, _ []string) error {
 
 	// Start services.
 
-	err = startServers(ctx, grpcserver)
+	err = startServers(ctx, grpcserver, tlsReloader)
 
 	return wraperror.Errorf(err, wraperror.NoMessage)
 }
@@ -360,7 +753,11 @@ func Version() string {
 // Private functions
 // ----------------------------------------------------------------------------
 
-func buildBasicGrpcServer(ctx context.Context) (*grpcserver.BasicGrpcServer, error) {
+func buildBasicGrpcServer(
+	ctx context.Context,
+	tracerProvider trace.TracerProvider,
+	tlsReloader *tlsreload.Reloader,
+) (*grpcserver.BasicGrpcServer, error) {
 	var (
 		err    error
 		result *grpcserver.BasicGrpcServer
@@ -376,9 +773,65 @@ func buildBasicGrpcServer(ctx context.Context) (*grpcserver.BasicGrpcServer, err
 		}
 	}
 
+	// Authentication.
+
+	authenticators, err := getAuthenticators()
+	if err != nil {
+		return result, wraperror.Errorf(err, "getAuthenticators")
+	}
+
+	// Authorization.  No policy means every authenticated caller may call every method.
+
+	var accessPolicy *grpcserver.AccessPolicy
+
+	policyFile := viper.GetString(authPolicyFile.Arg)
+	if len(policyFile) > 0 {
+		accessPolicy, err = grpcserver.NewAccessPolicy(policyFile)
+		if err != nil {
+			return result, wraperror.Errorf(err, "NewAccessPolicy")
+		}
+	}
+
+	// Rate limits.  No limits means calls are only bounded by SENZING_TOOLS_SERVER_MAX_CONCURRENT_STREAMS.
+
+	var rateLimits *grpcserver.RateLimits
+
+	limitsFile := viper.GetString(rateLimitsFile.Arg)
+	if len(limitsFile) > 0 {
+		rateLimits, err = grpcserver.NewRateLimits(limitsFile)
+		if err != nil {
+			return result, wraperror.Errorf(err, "NewRateLimits")
+		}
+	}
+
+	// Timeouts.  No timeouts means calls run until the client's deadline, if any.
+
+	var methodTimeouts []grpcserver.MethodTimeout
+
+	timeoutsFilename := viper.GetString(timeoutsFile.Arg)
+	if len(timeoutsFilename) > 0 {
+		methodTimeouts, err = grpcserver.NewMethodTimeouts(timeoutsFilename)
+		if err != nil {
+			return result, wraperror.Errorf(err, "NewMethodTimeouts")
+		}
+	}
+
+	// Redo processing.  No workers means redo records are left for clients to process.
+
+	var redoProcessing *grpcserver.RedoProcessing
+
+	if viper.GetInt(redoWorkers.Arg) > 0 {
+		redoProcessing = &grpcserver.RedoProcessing{
+			IdleBackoff:    time.Duration(viper.GetInt(redoIdleBackoffInSeconds.Arg)) * time.Second,
+			MaxIdleBackoff: time.Duration(viper.GetInt(redoMaxIdleBackoffInSeconds.Arg)) * time.Second,
+			MaxPerSecond:   float64(viper.GetInt(redoMaxPerSecond.Arg)),
+			Workers:        viper.GetInt(redoWorkers.Arg),
+		}
+	}
+
 	// Aggregate gRPC server options.
 
-	grpcServerOptions, err := getGrpcServerOptions(ctx)
+	grpcServerOptions, err := getGrpcServerOptions(ctx, tlsReloader)
 	if err != nil {
 		return result, wraperror.Errorf(err, "getGrpcServerOptions")
 	}
@@ -386,88 +839,129 @@ func buildBasicGrpcServer(ctx context.Context) (*grpcserver.BasicGrpcServer, err
 	// Create Server.
 
 	result = &grpcserver.BasicGrpcServer{
+		AccessPolicy:          accessPolicy,
+		Authenticators:        authenticators,
 		AvoidServing:          viper.GetBool(option.AvoidServe.Arg),
+		BatchMaxItems:         viper.GetInt(batchMaxItems.Arg),
+		BatchWorkers:          viper.GetInt(batchWorkers.Arg),
 		BindAddress:           viper.GetString(option.BindAddress.Arg),
+		BulkProgressInterval:  viper.GetInt(bulkProgressInterval.Arg),
+		BulkWorkers:           viper.GetInt(bulkWorkers.Arg),
 		EnableAll:             viper.GetBool(option.EnableAll.Arg),
+		EnableNotifications:   viper.GetBool(enableNotifications.Arg),
 		EnableSzConfig:        viper.GetBool(option.EnableSzConfig.Arg),
 		EnableSzConfigManager: viper.GetBool(option.EnableSzConfigManager.Arg),
 		EnableSzDiagnostic:    viper.GetBool(option.EnableSzDiagnostic.Arg),
 		EnableSzEngine:        viper.GetBool(option.EnableSzEngine.Arg),
 		EnableSzProduct:       viper.GetBool(option.EnableSzProduct.Arg),
-		GrpcServerOptions:     grpcServerOptions,
+		ExportDirectory:       viper.GetString(exportDirectory.Arg),
+		ExportIdleTTL:         time.Duration(viper.GetInt(exportIdleTTLInSeconds.Arg)) * time.Second,
+		ExportResumption: grpcserver.ExportResumption{
+			CheckpointInterval: viper.GetInt64(exportCheckpointInterval.Arg),
+			TokenKey:           []byte(viper.GetString(exportResumeTokenKey.Arg)),
+			TokenTTL:           time.Duration(viper.GetInt(exportResumeTokenTTLInSeconds.Arg)) * time.Second,
+		},
+		GrpcServerOptions:   grpcServerOptions,
+		HealthCheckInterval: time.Duration(viper.GetInt(healthCheckIntervalInSeconds.Arg)) * time.Second,
+		Jobs: grpcserver.Jobs{
+			MaxFinished: viper.GetInt(maxFinishedJobs.Arg),
+			MaxRunning:  viper.GetInt(maxRunningJobs.Arg),
+			StateFile:   viper.GetString(jobStateFile.Arg),
+		},
 		LogLevelName:          viper.GetString(option.LogLevel.Arg),
+		MethodTimeouts:        methodTimeouts,
 		ObserverOrigin:        viper.GetString(option.ObserverOrigin.Arg),
 		ObserverURL:           viper.GetString(option.ObserverURL.Arg),
 		Port:                  viper.GetInt(option.GrpcPort.Arg),
+		RateLimits:            rateLimits,
+		ReadOnly:              viper.GetBool(readOnly.Arg),
+		RedoProcessing:        redoProcessing,
 		SenzingInstanceName:   viper.GetString(option.CoreInstanceName.Arg),
 		SenzingSettings:       senzingSettings,
 		SenzingVerboseLogging: viper.GetInt64(option.CoreLogLevel.Arg),
+		SinglePort:            viper.GetBool(singlePort.Arg),
+		TracerProvider:        tracerProvider,
 	}
 
 	return result, err
 }
 
-func buildBasicHTTPServer(grpcServer *grpc.Server) *httpserver.BasicHTTPServer {
-	return &httpserver.BasicHTTPServer{
+func buildBasicHTTPServer(
+	grpcServer *grpcserver.BasicGrpcServer,
+	tlsReloader *tlsreload.Reloader,
+) (*httpserver.BasicHTTPServer, error) {
+	var err error
+
+	// CORS.  No policy means browsers may only call from the server's own origin.
+
+	var corsPolicy *httpserver.CORSPolicy
+
+	allowedOrigins := viper.GetStringSlice(corsAllowedOrigins.Arg)
+	policyFile := viper.GetString(corsPolicyFile.Arg)
+
+	switch {
+	case len(policyFile) > 0 && len(allowedOrigins) > 0:
+		return nil, wraperror.Errorf(errPackage, "only one of %s and %s may be set", corsPolicyFile.Envar, corsAllowedOrigins.Envar)
+	case len(policyFile) > 0:
+		corsPolicy, err = httpserver.NewCORSPolicy(policyFile)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "NewCORSPolicy")
+		}
+	case len(allowedOrigins) > 0:
+		corsPolicy = &httpserver.CORSPolicy{CORSRule: httpserver.CORSRule{AllowedOrigins: allowedOrigins}}
+	}
+
+	result := &httpserver.BasicHTTPServer{
 		AvoidServing:    viper.GetBool(option.AvoidServe.Arg),
+		CORSPolicy:      corsPolicy,
 		EnableAll:       viper.GetBool(option.EnableAll.Arg),
 		EnableGRPC:      viper.GetBool(enableHTTP.Arg),
+		EnableREST:      viper.GetBool(enableREST.Arg),
+		EnableWebSocket: viper.GetBool(enableWebSocket.Arg),
 		GRPCRoutePrefix: "grpc",
-		GRPCServer:      grpcServer,
+		GRPCServer:      grpcServer.GetGRPCServer(),
 		LogLevelName:    viper.GetString(option.LogLevel.Arg),
+		MetricsHandler:  grpcServer.GetMetricsHandler(),
+		MetricsPath:     viper.GetString(metricsPath.Arg),
 		ServerAddress:   viper.GetString(option.ServerAddress.Arg),
 		ServerPort:      viper.GetInt(option.HTTPPort.Arg),
+		SinglePort:      viper.GetBool(singlePort.Arg),
 	}
-}
 
-func buildGrpcServerOption(
-	certificates []tls.Certificate,
-	clientAuth tls.ClientAuthType,
-	clientCAs *x509.CertPool,
-) grpc.ServerOption {
-	tlsConfig := &tls.Config{
-		Certificates: certificates,
-		ClientAuth:   clientAuth,
-		ClientCAs:    clientCAs,
-		MinVersion:   tls.VersionTLS12, // See https://pkg.go.dev/crypto/tls#pkg-constants
-		MaxVersion:   tls.VersionTLS13,
+	if tlsReloader != nil {
+		result.TLSConfig = tlsReloader.TLSConfig()
 	}
-	transportCredentials := credentials.NewTLS(tlsConfig)
-	result := grpc.Creds(transportCredentials)
 
-	return result
+	return result, nil
 }
 
-func createTLSOption(
-	ctx context.Context,
-	serverCertificatePathValue string,
-	serverKeyPathValue string,
-) (grpc.ServerOption, error) {
-	var (
-		err          error
-		result       grpc.ServerOption
-		clientCAs    *x509.CertPool
-		clientAuth   tls.ClientAuthType
-		certificates []tls.Certificate
-	)
-
-	// Server-side TLS.
+// Load the server certificate selected by the SENZING_TOOLS_SERVER_* options.
+// No certificate means TLS is off and the returned Reloader is nil.
+func buildTLSReloader(ctx context.Context) (*tlsreload.Reloader, error) {
+	serverCertificatePathValue := viper.GetString(serverCertificateFile.Arg)
+	serverKeyPathValue := viper.GetString(serverKeyFile.Arg)
 
-	serverKeyPassPhraseValue := viper.GetString(serverKeyPassPhrase.Arg)
-	clientAuth = tls.NoClientCert
+	// Determine if TLS is requested.
 
-	serverCertificate, err := tlshelper.LoadX509KeyPair(
-		ctx,
-		serverCertificatePathValue,
-		serverKeyPathValue,
-		serverKeyPassPhraseValue,
-	)
-	if err != nil {
-		return result, wraperror.Errorf(err, "LoadX509KeyPair")
+	switch {
+	case serverCertificatePathValue == "" && serverKeyPathValue == "":
+		return nil, nil
+	case serverCertificatePathValue != "" && serverKeyPathValue == "":
+		return nil, wraperror.Errorf(
+			errPackage,
+			"%s is set, but %s is not set. Both need to be set",
+			serverCertificateFile.Envar,
+			serverKeyFile.Envar,
+		)
+	case serverCertificatePathValue == "" && serverKeyPathValue != "":
+		return nil, wraperror.Errorf(
+			errPackage,
+			"%s is set, but %s is not set. Both need to be set",
+			serverKeyFile.Envar,
+			serverCertificateFile.Envar,
+		)
 	}
 
-	certificates = []tls.Certificate{serverCertificate}
-
 	// Mutual TLS.
 
 	caCertificatePathsValue := viper.GetStringSlice(clientCaCertificateFiless.Arg)
@@ -477,39 +971,67 @@ func createTLSOption(
 		caCertificatePathsValue = append(caCertificatePathsValue, caCertificatePathValue)
 	}
 
-	if len(caCertificatePathsValue) > 0 {
-		clientAuth = tls.RequireAndVerifyClientCert
-		clientCAs = x509.NewCertPool()
+	result, err := tlsreload.NewReloader(
+		ctx,
+		serverCertificatePathValue,
+		serverKeyPathValue,
+		viper.GetString(serverKeyPassPhrase.Arg),
+		caCertificatePathsValue,
+	)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "NewReloader")
+	}
+
+	return result, nil
+}
+
+// Build the authenticators selected by the SENZING_TOOLS_AUTH_* options, client certificates first.
+// No authenticators means authentication is off.
+func getAuthenticators() ([]grpcserver.Authenticator, error) {
+	var result []grpcserver.Authenticator
 
-		for _, caCertificatePath := range caCertificatePathsValue {
-			pemClientCA, err := os.ReadFile(caCertificatePath)
-			if err != nil {
-				return result, wraperror.Errorf(err, "os.ReadFile %s", caCertificatePath)
-			}
+	if viper.GetBool(authMtls.Arg) {
+		result = append(result, &grpcserver.MTLSAuthenticator{})
+	}
 
-			if !clientCAs.AppendCertsFromPEM(pemClientCA) {
-				return result, wraperror.Errorf(
-					errPackage,
-					"failed to add client CA's certificate for %s",
-					caCertificatePath,
-				)
-			}
+	apiKeysFile := viper.GetString(authAPIKeysFile.Arg)
+	if len(apiKeysFile) > 0 {
+		authenticator, err := grpcserver.NewAPIKeyAuthenticator(apiKeysFile)
+		if err != nil {
+			return result, wraperror.Errorf(err, "NewAPIKeyAuthenticator")
 		}
+
+		result = append(result, authenticator)
 	}
 
-	result = buildGrpcServerOption(certificates, clientAuth, clientCAs)
+	jwksFile := viper.GetString(authJwksFile.Arg)
+	if len(jwksFile) > 0 {
+		authenticator, err := grpcserver.NewJWTAuthenticator(
+			jwksFile,
+			viper.GetString(authJwtIssuer.Arg),
+			viper.GetString(authJwtAudience.Arg),
+		)
+		if err != nil {
+			return result, wraperror.Errorf(err, "NewJWTAuthenticator")
+		}
+
+		result = append(result, authenticator)
+	}
 
-	return result, wraperror.Errorf(err, wraperror.NoMessage)
+	return result, nil
 }
 
-func getGrpcServerOptions(ctx context.Context) ([]grpc.ServerOption, error) {
+func getGrpcServerOptions(ctx context.Context, tlsReloader *tlsreload.Reloader) ([]grpc.ServerOption, error) {
 	var (
 		err    error
 		result []grpc.ServerOption
 	)
 
+	if tlsReloader != nil {
+		result = append(result, grpc.Creds(credentials.NewTLS(tlsReloader.TLSConfig())))
+	}
+
 	optionFunctions := []func(context.Context) (grpc.ServerOption, error){
-		getTLSOption,
 		getKeepaliveEnforcementPolicyOption,
 		getKeepaliveParamsOption,
 		getMaxConcurrentStreamsOption,
@@ -711,80 +1233,93 @@ func getWriteBufferSizeOption(ctx context.Context) (grpc.ServerOption, error) {
 	return result, err
 }
 
-// Get the appropriate GRPC server options.
-func getTLSOption(ctx context.Context) (grpc.ServerOption, error) {
-	var (
-		err    error
-		result grpc.ServerOption
-	)
+// Since init() is always invoked, define command line parameters.
+func init() {
+	cmdhelper.Init(RootCmd, ContextVariables)
+}
 
-	serverCertificatePathValue := viper.GetString(serverCertificateFile.Arg)
-	serverKeyPathValue := viper.GetString(serverKeyFile.Arg)
+func shutdownServers(
+	ctx context.Context,
+	grpcserver *grpcserver.BasicGrpcServer,
+	httpserver *httpserver.BasicHTTPServer,
+) error {
+	var httpErr error
 
-	// Determine if TLS is requested.
+	// The serving context may already be cancelled by a signal, so the drain gets its own deadline.
 
-	switch {
-	case serverCertificatePathValue != "" && serverKeyPathValue != "":
-		result, err = createTLSOption(ctx, serverCertificatePathValue, serverKeyPathValue)
-	case serverCertificatePathValue != "" && serverKeyPathValue == "":
-		err = wraperror.Errorf(
-			errPackage,
-			"%s is set, but %s is not set. Both need to be set",
-			serverCertificateFile.Envar,
-			serverKeyFile.Envar,
-		)
-	case serverCertificatePathValue == "" && serverKeyPathValue != "":
-		err = wraperror.Errorf(
-			errPackage,
-			"%s is set, but %s is not set. Both need to be set",
-			serverKeyFile.Envar,
-			serverCertificateFile.Envar,
-		)
-	default:
-		err = nil
+	shutdownTimeout := time.Duration(viper.GetInt(shutdownTimeoutInSeconds.Arg)) * time.Second
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+
+	defer cancel()
+
+	// Stop the HTTP server first, as it forwards to the gRPC server.  The gRPC server is
+	// shut down even if the HTTP server fails to, so that the Senzing SDK is destroyed.
+
+	if httpserver != nil {
+		err := httpserver.Shutdown(shutdownCtx)
+		if err != nil {
+			httpErr = wraperror.Errorf(err, "httpserver.Shutdown")
+		}
 	}
 
-	return result, err
-}
+	var grpcErr error
 
-// Since init() is always invoked, define command line parameters.
-func init() {
-	cmdhelper.Init(RootCmd, ContextVariables)
+	err := grpcserver.Shutdown(shutdownCtx)
+	if err != nil {
+		grpcErr = wraperror.Errorf(err, "grpcserver.Shutdown")
+	}
+
+	return errors.Join(httpErr, grpcErr)
 }
 
-func startServers(ctx context.Context, grpcserver *grpcserver.BasicGrpcServer) error {
+func startServers(
+	ctx context.Context,
+	grpcserver *grpcserver.BasicGrpcServer,
+	tlsReloader *tlsreload.Reloader,
+) error {
 	var (
-		err       error
-		waitGroup sync.WaitGroup
+		err        error
+		httpServer *httpserver.BasicHTTPServer
+		serveErr   error
 	)
 
-	waitGroup.Add(1)
-
-	go func() {
-		defer waitGroup.Done()
-
-		err = grpcserver.Serve(ctx)
+	if viper.GetBool(enableHTTP.Arg) || viper.GetBool(enableREST.Arg) || viper.GetBool(enableWebSocket.Arg) || viper.GetBool(singlePort.Arg) {
+		httpServer, err = buildBasicHTTPServer(grpcserver, tlsReloader)
 		if err != nil {
-			panic(wraperror.Errorf(err, "grpcserver.Serve"))
+			return wraperror.Errorf(err, "buildBasicHTTPServer")
 		}
-	}()
+	}
 
-	if viper.GetBool(enableHTTP.Arg) {
-		httpserver := buildBasicHTTPServer(grpcserver.GetGRPCServer())
+	// The servers share one lifecycle: when either stops, on its own or with an error, both are shut down.
 
-		waitGroup.Add(1)
+	serveErrors := make(chan error, 2) //nolint:mnd // One per server.
+	running := 1
 
-		go func() {
-			defer waitGroup.Done()
+	go func() {
+		serveErrors <- wraperror.Errorf(grpcserver.Serve(ctx), "grpcserver.Serve")
+	}()
 
-			err = httpserver.Serve(ctx)
-			if err != nil {
-				panic(wraperror.Errorf(err, "httpserver.Serve"))
-			}
+	if httpServer != nil {
+		running++
+
+		go func() {
+			serveErrors <- wraperror.Errorf(httpServer.Serve(ctx), "httpserver.Serve")
 		}()
 	}
 
-	waitGroup.Wait()
+	// Wait until a server stops or a signal is received.
 
-	return wraperror.Errorf(err, wraperror.NoMessage)
+	select {
+	case serveErr = <-serveErrors:
+		running--
+	case <-ctx.Done():
+	}
+
+	err = shutdownServers(ctx, grpcserver, httpServer)
+
+	for ; running > 0; running-- {
+		serveErr = errors.Join(serveErr, <-serveErrors)
+	}
+
+	return wraperror.Errorf(errors.Join(serveErr, err), wraperror.NoMessage)
 }
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Values of SENZING_TOOLS_TRACE_EXPORTER.
+const (
+	traceExporterValueFile   = "file"
+	traceExporterValueNone   = "none"
+	traceExporterValueOtlp   = "otlp"
+	traceExporterValueStdout = "stdout"
+)
+
+const (
+	traceFilePermissions = 0o600
+	traceServiceName     = "serve-grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+/*
+Build the OpenTelemetry TracerProvider selected by SENZING_TOOLS_TRACE_EXPORTER.
+
+If no exporter is selected, the TracerProvider is nil and the gRPC server falls back to
+the global (by default no-op) TracerProvider.  The returned function flushes pending
+spans and releases the exporter; call it after the servers have shut down.
+*/
+func buildTracerProvider(ctx context.Context) (trace.TracerProvider, func(context.Context) error, error) {
+	noShutdown := func(context.Context) error { return nil }
+
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+		file     *os.File
+	)
+
+	switch viper.GetString(traceExporter.Arg) {
+	case "", traceExporterValueNone:
+		return nil, noShutdown, nil
+	case traceExporterValueOtlp:
+		exporter, err = otlptracegrpc.New(ctx, getOtlpTraceOptions()...)
+	case traceExporterValueStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case traceExporterValueFile:
+		file, err = os.OpenFile(
+			viper.GetString(traceExporterFile.Arg),
+			os.O_APPEND|os.O_CREATE|os.O_WRONLY,
+			traceFilePermissions,
+		)
+		if err != nil {
+			return nil, noShutdown, wraperror.Errorf(err, "os.OpenFile %s", viper.GetString(traceExporterFile.Arg))
+		}
+
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(file))
+	default:
+		err = wraperror.Errorf(
+			errPackage,
+			"%s must be one of %s, %s, %s or %s; not %q",
+			traceExporter.Envar,
+			traceExporterValueNone,
+			traceExporterValueOtlp,
+			traceExporterValueStdout,
+			traceExporterValueFile,
+			viper.GetString(traceExporter.Arg),
+		)
+	}
+
+	if err != nil {
+		return nil, noShutdown, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	traceResource, err := resource.Merge(
+		resource.Default(),
+		resource.NewSchemaless(
+			semconv.ServiceName(traceServiceName),
+			semconv.ServiceVersion(Version()),
+		),
+	)
+	if err != nil {
+		return nil, noShutdown, wraperror.Errorf(err, "resource.Merge")
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(traceResource),
+	)
+
+	shutdown := func(ctx context.Context) error {
+		err := tracerProvider.Shutdown(ctx)
+		if file != nil {
+			err = errors.Join(err, file.Close())
+		}
+
+		return wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	return tracerProvider, shutdown, nil
+}
+
+func getOtlpTraceOptions() []otlptracegrpc.Option {
+	var result []otlptracegrpc.Option
+
+	endpoint := viper.GetString(traceExporterOtlpEndpoint.Arg)
+	if len(endpoint) > 0 {
+		result = append(result, otlptracegrpc.WithEndpoint(endpoint))
+	}
+
+	if viper.GetBool(traceExporterOtlpInsecure.Arg) {
+		result = append(result, otlptracegrpc.WithInsecure())
+	}
+
+	return result
+}
@@ -0,0 +1,5 @@
+/*
+Package fileexport writes the rows of a Senzing entity report to files as NDJSON, CSV or
+Parquet, optionally compressed, starting a new file whenever one grows past a size.
+*/
+package fileexport
@@ -0,0 +1,335 @@
+package fileexport
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Writer writes rows to files as configured by Options.  Write and Close must be called
+from one goroutine; BytesWritten, Files and RowsWritten may be called from any.
+*/
+type Writer struct {
+	bytesWritten atomic.Int64
+	current      *exportFile
+	files        []string
+	filesMutex   sync.Mutex
+	header       string
+	options      Options
+	rowsWritten  atomic.Int64
+}
+
+// An exportFile is the file being written.
+type exportFile struct {
+	encoder rowEncoder
+	file    *os.File
+}
+
+// A rowEncoder writes rows in one format.
+type rowEncoder interface {
+	close() error // Flush everything.  The underlying file is closed by the caller.
+	size() int64  // Bytes in the file, counting those still buffered.
+	writeRow(row string) error
+}
+
+// A countingWriter counts the bytes written through it.
+type countingWriter struct {
+	count  int64
+	total  *atomic.Int64
+	writer io.Writer
+}
+
+// A streamEncoder writes NDJSON or CSV rows, one per line.
+type streamEncoder struct {
+	buffered   *bufio.Writer
+	compressor io.WriteCloser
+	counter    *countingWriter
+	output     io.Writer
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const bufferSize = 64 * 1024
+
+// Exports are meant to be read by other tools sharing the volume.
+const filePermissions = 0o644
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var validName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewWriter function checks options and returns a Writer.  No file is created
+until the first row is written or the Writer is closed.
+*/
+func NewWriter(options Options) (*Writer, error) {
+	switch options.Format {
+	case FormatCSV, FormatNDJSON, FormatParquet:
+	default:
+		return nil, wraperror.Errorf(errPackage, "unknown format %q", options.Format)
+	}
+
+	switch options.Compression {
+	case CompressionNone, CompressionGzip, CompressionZstd:
+	default:
+		return nil, wraperror.Errorf(errPackage, "unknown compression %q", options.Compression)
+	}
+
+	if !validName.MatchString(options.Name) {
+		return nil, wraperror.Errorf(errPackage, "file name %q may only contain letters, digits, '.', '_' and '-'", options.Name)
+	}
+
+	directoryInfo, err := os.Stat(options.Directory)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "Stat")
+	}
+
+	if !directoryInfo.IsDir() {
+		return nil, wraperror.Errorf(errPackage, "%s is not a directory", options.Directory)
+	}
+
+	return &Writer{options: options}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+// The BytesWritten method returns the bytes written to all files so far.
+func (writer *Writer) BytesWritten() int64 {
+	return writer.bytesWritten.Load()
+}
+
+/*
+The Close method finishes the current file.  If no row was written, it writes one
+file with no rows, so that every export leaves a file behind.
+*/
+func (writer *Writer) Close() error {
+	if writer.current == nil {
+		err := writer.openFile()
+		if err != nil {
+			return err
+		}
+	}
+
+	return writer.closeFile()
+}
+
+// The Files method returns the paths of the files written so far, in order.
+func (writer *Writer) Files() []string {
+	writer.filesMutex.Lock()
+	defer writer.filesMutex.Unlock()
+
+	return append([]string(nil), writer.files...)
+}
+
+// The RowsWritten method returns the rows written so far, not counting CSV headers.
+func (writer *Writer) RowsWritten() int64 {
+	return writer.rowsWritten.Load()
+}
+
+/*
+The Write method writes one row returned by FetchNext.  For FormatCSV and
+FormatParquet the first row is taken as the header.
+*/
+func (writer *Writer) Write(row string) error {
+	row = strings.TrimRight(row, "\r\n")
+	if len(row) == 0 {
+		return nil
+	}
+
+	if writer.options.Format != FormatNDJSON && len(writer.header) == 0 {
+		writer.header = row
+
+		return nil
+	}
+
+	if writer.current != nil && writer.options.MaxFileBytes > 0 &&
+		writer.current.encoder.size() >= writer.options.MaxFileBytes {
+		err := writer.closeFile()
+		if err != nil {
+			return err
+		}
+	}
+
+	if writer.current == nil {
+		err := writer.openFile()
+		if err != nil {
+			return err
+		}
+	}
+
+	err := writer.current.encoder.writeRow(row)
+	if err != nil {
+		return err
+	}
+
+	writer.rowsWritten.Add(1)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (writer *Writer) openFile() error {
+	writer.filesMutex.Lock()
+	path := filepath.Join(
+		writer.options.Directory,
+		fmt.Sprintf("%s-%05d%s", writer.options.Name, len(writer.files)+1, writer.extension()),
+	)
+	writer.filesMutex.Unlock()
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, filePermissions)
+	if err != nil {
+		return wraperror.Errorf(err, "OpenFile")
+	}
+
+	counter := &countingWriter{total: &writer.bytesWritten, writer: file}
+
+	var encoder rowEncoder
+
+	switch writer.options.Format {
+	case FormatParquet:
+		encoder, err = newParquetEncoder(counter, writer.options.Compression, writer.header)
+	default:
+		encoder, err = newStreamEncoder(counter, writer.options.Compression, writer.header)
+	}
+
+	if err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	writer.current = &exportFile{encoder: encoder, file: file}
+
+	writer.filesMutex.Lock()
+	writer.files = append(writer.files, path)
+	writer.filesMutex.Unlock()
+
+	return nil
+}
+
+func (writer *Writer) closeFile() error {
+	current := writer.current
+	writer.current = nil
+
+	err := current.encoder.close()
+	if err != nil {
+		_ = current.file.Close()
+
+		return err
+	}
+
+	return wraperror.Errorf(current.file.Close(), "Close")
+}
+
+func (writer *Writer) extension() string {
+	result := "." + string(writer.options.Format)
+	if writer.options.Format == FormatParquet {
+		return result // Parquet compresses its pages instead of the file.
+	}
+
+	switch writer.options.Compression {
+	case CompressionGzip:
+		result += ".gz"
+	case CompressionZstd:
+		result += ".zst"
+	case CompressionNone:
+	}
+
+	return result
+}
+
+func (counter *countingWriter) Write(buffer []byte) (int, error) {
+	count, err := counter.writer.Write(buffer)
+	counter.count += int64(count)
+	counter.total.Add(int64(count))
+
+	return count, err //nolint:wrapcheck // io.Writer errors pass through unchanged.
+}
+
+func (encoder *streamEncoder) close() error {
+	if encoder.compressor != nil {
+		err := encoder.compressor.Close()
+		if err != nil {
+			return wraperror.Errorf(err, "compressor.Close")
+		}
+	}
+
+	return wraperror.Errorf(encoder.buffered.Flush(), "Flush")
+}
+
+func (encoder *streamEncoder) size() int64 {
+	return encoder.counter.count + int64(encoder.buffered.Buffered())
+}
+
+func (encoder *streamEncoder) writeRow(row string) error {
+	_, err := io.WriteString(encoder.output, row+"\n")
+
+	return wraperror.Errorf(err, "WriteString")
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Write rows to counter through a buffer and the compressor, starting with header if it is set.
+func newStreamEncoder(counter *countingWriter, compression Compression, header string) (*streamEncoder, error) {
+	result := &streamEncoder{
+		buffered: bufio.NewWriterSize(counter, bufferSize),
+		counter:  counter,
+	}
+	result.output = result.buffered
+
+	switch compression {
+	case CompressionGzip:
+		result.compressor = gzip.NewWriter(result.buffered)
+	case CompressionZstd:
+		compressor, err := zstd.NewWriter(result.buffered)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "zstd.NewWriter")
+		}
+
+		result.compressor = compressor
+	case CompressionNone:
+	}
+
+	if result.compressor != nil {
+		result.output = result.compressor
+	}
+
+	if len(header) > 0 {
+		err := result.writeRow(header)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
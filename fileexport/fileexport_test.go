@@ -0,0 +1,420 @@
+package fileexport_test
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/csv"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/senzing-garage/serve-grpc/fileexport"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testCsvRows = []string{
+		"RESOLVED_ENTITY_ID,RELATED_ENTITY_ID,MATCH_LEVEL,DATA_SOURCE,RECORD_ID\n",
+		"1,0,0,\"CUSTOMERS\",\"1001\"\n",
+		"1,0,1,\"CUSTOMERS\",\"1002\"\n",
+		"2,0,0,\"REFERENCE\",\"2001\"\n",
+	}
+	testJSONRows = []string{
+		`{"RESOLVED_ENTITY":{"ENTITY_ID":1}}` + "\n",
+		`{"RESOLVED_ENTITY":{"ENTITY_ID":2}}` + "\n",
+	}
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestWriter_ndjson(test *testing.T) {
+	writer := writeRows(test, fileexport.Options{Format: fileexport.FormatNDJSON}, testJSONRows)
+
+	require.Equal(test, int64(2), writer.RowsWritten())
+	require.Len(test, writer.Files(), 1)
+	require.Equal(test, "export-00001.ndjson", filepath.Base(writer.Files()[0]))
+
+	contents, err := os.ReadFile(writer.Files()[0])
+	require.NoError(test, err)
+	require.Equal(test, strings.Join(testJSONRows, ""), string(contents))
+	require.Equal(test, int64(len(contents)), writer.BytesWritten())
+}
+
+func TestWriter_csv_gzip_rotation(test *testing.T) {
+	options := fileexport.Options{
+		Compression:  fileexport.CompressionGzip,
+		Format:       fileexport.FormatCSV,
+		MaxFileBytes: 1,
+	}
+	writer := writeRows(test, options, testCsvRows)
+
+	require.Equal(test, int64(3), writer.RowsWritten())
+	require.Len(test, writer.Files(), 3)
+
+	for index, path := range writer.Files() {
+		file, err := os.Open(path)
+		require.NoError(test, err)
+
+		reader, err := gzip.NewReader(file)
+		require.NoError(test, err)
+
+		lines := readLines(test, reader)
+		require.Equal(test, []string{strings.TrimSpace(testCsvRows[0]), strings.TrimSpace(testCsvRows[index+1])}, lines)
+		require.NoError(test, file.Close())
+	}
+}
+
+func TestWriter_ndjson_zstd(test *testing.T) {
+	options := fileexport.Options{Compression: fileexport.CompressionZstd, Format: fileexport.FormatNDJSON}
+	writer := writeRows(test, options, testJSONRows)
+
+	require.Equal(test, "export-00001.ndjson.zst", filepath.Base(writer.Files()[0]))
+
+	file, err := os.Open(writer.Files()[0])
+	require.NoError(test, err)
+
+	defer file.Close()
+
+	reader, err := zstd.NewReader(file)
+	require.NoError(test, err)
+
+	defer reader.Close()
+
+	require.Len(test, readLines(test, reader), 2)
+}
+
+func TestWriter_parquet(test *testing.T) {
+	expected := make([][]string, 0, len(testCsvRows))
+
+	for _, row := range testCsvRows {
+		fields, err := csv.NewReader(strings.NewReader(row)).Read()
+		require.NoError(test, err)
+
+		expected = append(expected, fields)
+	}
+
+	for _, testCase := range []struct {
+		compression fileexport.Compression
+		codec       int64
+	}{
+		{compression: fileexport.CompressionNone, codec: 0},
+		{compression: fileexport.CompressionGzip, codec: 2},
+		{compression: fileexport.CompressionZstd, codec: 6},
+	} {
+		test.Run(string(testCase.compression), func(test *testing.T) {
+			options := fileexport.Options{Compression: testCase.compression, Format: fileexport.FormatParquet}
+			writer := writeRows(test, options, testCsvRows)
+
+			require.Equal(test, int64(3), writer.RowsWritten())
+			require.Equal(test, "export-00001.parquet", filepath.Base(writer.Files()[0]))
+
+			contents, err := os.ReadFile(writer.Files()[0])
+			require.NoError(test, err)
+			require.Equal(test, expected, readParquet(test, contents, testCase.codec))
+		})
+	}
+}
+
+func TestWriter_emptyExport(test *testing.T) {
+	writer := writeRows(test, fileexport.Options{Format: fileexport.FormatCSV}, testCsvRows[:1])
+
+	require.Zero(test, writer.RowsWritten())
+	require.Len(test, writer.Files(), 1)
+
+	contents, err := os.ReadFile(writer.Files()[0])
+	require.NoError(test, err)
+	require.Equal(test, testCsvRows[0], string(contents))
+}
+
+func TestNewWriter_badOptions(test *testing.T) {
+	directory := test.TempDir()
+
+	for _, options := range []fileexport.Options{
+		{Directory: directory, Format: "xml", Name: "export"},
+		{Directory: directory, Format: fileexport.FormatCSV, Compression: "bzip2", Name: "export"},
+		{Directory: directory, Format: fileexport.FormatCSV, Name: "../export"},
+		{Directory: directory, Format: fileexport.FormatCSV, Name: ""},
+		{Directory: filepath.Join(directory, "missing"), Format: fileexport.FormatCSV, Name: "export"},
+	} {
+		_, err := fileexport.NewWriter(options)
+		require.Error(test, err, options)
+	}
+}
+
+func TestWriter_existingFile(test *testing.T) {
+	options := fileexport.Options{Directory: test.TempDir(), Format: fileexport.FormatNDJSON, Name: "export"}
+
+	err := os.WriteFile(filepath.Join(options.Directory, "export-00001.ndjson"), nil, 0o600)
+	require.NoError(test, err)
+
+	writer, err := fileexport.NewWriter(options)
+	require.NoError(test, err)
+	require.Error(test, writer.Write(testJSONRows[0]))
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func writeRows(test *testing.T, options fileexport.Options, rows []string) *fileexport.Writer {
+	test.Helper()
+
+	options.Directory = test.TempDir()
+	options.Name = "export"
+
+	writer, err := fileexport.NewWriter(options)
+	require.NoError(test, err)
+
+	for _, row := range rows {
+		require.NoError(test, writer.Write(row))
+	}
+
+	require.NoError(test, writer.Close())
+
+	return writer
+}
+
+/*
+Read a Parquet file of required UTF8 columns, returning the column names followed by
+the rows.  The file is decoded from the Parquet and Thrift compact protocol
+specifications, independently of the writer, and every column chunk must use codec.
+*/
+func readParquet(test *testing.T, contents []byte, codec int64) [][]string {
+	test.Helper()
+
+	require.Equal(test, "PAR1", string(contents[:4]))
+	require.Equal(test, "PAR1", string(contents[len(contents)-4:]))
+
+	footerSize := int(binary.LittleEndian.Uint32(contents[len(contents)-8:]))
+	require.Less(test, footerSize, len(contents)-12)
+
+	footer := readThriftStruct(test, bytes.NewReader(contents[len(contents)-8-footerSize:len(contents)-8]))
+
+	// The schema is a root element followed by one BYTE_ARRAY, REQUIRED, UTF8 element per column.
+
+	schema := thriftField[[]any](test, footer, 2)
+	require.Equal(test, int64(len(schema)-1), thriftField[int64](test, schema[0].(map[int16]any), 5))
+
+	header := make([]string, 0, len(schema)-1)
+
+	for _, element := range schema[1:] {
+		column := element.(map[int16]any)
+		require.Equal(test, int64(6), thriftField[int64](test, column, 1))
+		require.Equal(test, int64(0), thriftField[int64](test, column, 3))
+		require.Equal(test, int64(0), thriftField[int64](test, column, 6))
+
+		header = append(header, string(thriftField[[]byte](test, column, 4)))
+	}
+
+	result := [][]string{header}
+
+	for _, element := range thriftField[[]any](test, footer, 4) {
+		rowGroup := element.(map[int16]any)
+		numRows := thriftField[int64](test, rowGroup, 3)
+		rows := make([][]string, numRows)
+
+		for index := range rows {
+			rows[index] = make([]string, len(header))
+		}
+
+		columnChunks := thriftField[[]any](test, rowGroup, 1)
+		require.Len(test, columnChunks, len(header))
+
+		for column, columnChunk := range columnChunks {
+			values := readParquetColumnChunk(test, contents, columnChunk.(map[int16]any), codec, numRows)
+			for row, value := range values {
+				rows[row][column] = value
+			}
+		}
+
+		result = append(result, rows...)
+	}
+
+	require.Len(test, result, int(thriftField[int64](test, footer, 3))+1)
+
+	return result
+}
+
+// Read the values of a column chunk made of a single PLAIN data page.
+func readParquetColumnChunk(
+	test *testing.T,
+	contents []byte,
+	columnChunk map[int16]any,
+	codec int64,
+	numRows int64,
+) []string {
+	test.Helper()
+
+	metaData := thriftField[map[int16]any](test, columnChunk, 3)
+	require.Equal(test, codec, thriftField[int64](test, metaData, 4))
+	require.Equal(test, numRows, thriftField[int64](test, metaData, 5))
+
+	reader := bytes.NewReader(contents[thriftField[int64](test, metaData, 9):])
+	pageHeader := readThriftStruct(test, reader)
+	headerSize := int64(len(contents)) - thriftField[int64](test, metaData, 9) - int64(reader.Len())
+
+	require.Equal(test, int64(0), thriftField[int64](test, pageHeader, 1))
+
+	uncompressedSize := thriftField[int64](test, pageHeader, 2)
+	compressedSize := thriftField[int64](test, pageHeader, 3)
+	require.Equal(test, headerSize+uncompressedSize, thriftField[int64](test, metaData, 6))
+	require.Equal(test, headerSize+compressedSize, thriftField[int64](test, metaData, 7))
+
+	dataPageHeader := thriftField[map[int16]any](test, pageHeader, 5)
+	require.Equal(test, numRows, thriftField[int64](test, dataPageHeader, 1))
+	require.Equal(test, int64(0), thriftField[int64](test, dataPageHeader, 2))
+
+	page := make([]byte, compressedSize)
+	_, err := io.ReadFull(reader, page)
+	require.NoError(test, err)
+
+	switch codec {
+	case 2:
+		decompressor, err := gzip.NewReader(bytes.NewReader(page))
+		require.NoError(test, err)
+
+		page, err = io.ReadAll(decompressor)
+		require.NoError(test, err)
+	case 6:
+		decompressor, err := zstd.NewReader(nil)
+		require.NoError(test, err)
+
+		defer decompressor.Close()
+
+		page, err = decompressor.DecodeAll(page, nil)
+		require.NoError(test, err)
+	}
+
+	require.Len(test, page, int(uncompressedSize))
+
+	// Required columns have no levels, so the page is just PLAIN byte arrays.
+
+	result := make([]string, 0, numRows)
+
+	for range numRows {
+		require.GreaterOrEqual(test, len(page), 4)
+
+		size := int(binary.LittleEndian.Uint32(page))
+		require.GreaterOrEqual(test, len(page), 4+size)
+
+		result = append(result, string(page[4:4+size]))
+		page = page[4+size:]
+	}
+
+	require.Empty(test, page)
+
+	return result
+}
+
+// Read a Thrift compact protocol struct as a map from field id to value.
+func readThriftStruct(test *testing.T, reader *bytes.Reader) map[int16]any {
+	test.Helper()
+
+	result := map[int16]any{}
+
+	var fieldID int16
+
+	for {
+		fieldHeader, err := reader.ReadByte()
+		require.NoError(test, err)
+
+		if fieldHeader == 0 {
+			return result
+		}
+
+		if delta := int16(fieldHeader >> 4); delta != 0 {
+			fieldID += delta
+		} else {
+			longFieldID, err := binary.ReadVarint(reader)
+			require.NoError(test, err)
+
+			fieldID = int16(longFieldID)
+		}
+
+		switch fieldType := fieldHeader & 0x0f; fieldType {
+		case 1, 2: // Booleans are held in the field type.
+			result[fieldID] = fieldType == 1
+		default:
+			result[fieldID] = readThriftValue(test, reader, fieldType)
+		}
+	}
+}
+
+func readThriftValue(test *testing.T, reader *bytes.Reader, valueType byte) any {
+	test.Helper()
+
+	switch valueType {
+	case 1, 2, 3: // Boolean or byte.
+		value, err := reader.ReadByte()
+		require.NoError(test, err)
+
+		return int64(value)
+	case 4, 5, 6: // Zigzag varint i16, i32 or i64.
+		value, err := binary.ReadVarint(reader)
+		require.NoError(test, err)
+
+		return value
+	case 8: // Binary.
+		size, err := binary.ReadUvarint(reader)
+		require.NoError(test, err)
+
+		value := make([]byte, size)
+		_, err = io.ReadFull(reader, value)
+		require.NoError(test, err)
+
+		return value
+	case 9: // List.
+		listHeader, err := reader.ReadByte()
+		require.NoError(test, err)
+
+		size := uint64(listHeader >> 4)
+		if size == 15 {
+			size, err = binary.ReadUvarint(reader)
+			require.NoError(test, err)
+		}
+
+		result := make([]any, 0, size)
+		for range size {
+			result = append(result, readThriftValue(test, reader, listHeader&0x0f))
+		}
+
+		return result
+	case 12: // Struct.
+		return readThriftStruct(test, reader)
+	default:
+		require.Failf(test, "unexpected Thrift type", "type %d", valueType)
+
+		return nil
+	}
+}
+
+func thriftField[T any](test *testing.T, thriftStruct map[int16]any, fieldID int16) T {
+	test.Helper()
+
+	result, isType := thriftStruct[fieldID].(T)
+	require.True(test, isType, "field %d is %T", fieldID, thriftStruct[fieldID])
+
+	return result
+}
+
+func readLines(test *testing.T, reader interface{ Read([]byte) (int, error) }) []string {
+	test.Helper()
+
+	var result []string
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		result = append(result, scanner.Text())
+	}
+
+	require.NoError(test, scanner.Err())
+
+	return result
+}
@@ -0,0 +1,59 @@
+package fileexport
+
+import (
+	"errors"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Compression says how files are compressed.
+type Compression string
+
+// Format says how rows are written.
+type Format string
+
+/*
+Options configures a Writer.
+
+Files are named "<Name>-00001.<extension>", "<Name>-00002.<extension>" and so on in
+Directory.  Existing files are never overwritten.
+
+For FormatNDJSON each row is a JSON document from ExportJSONEntityReport.  For
+FormatCSV and FormatParquet rows come from ExportCsvEntityReport, and the first row is
+the header: it is repeated at the top of every CSV file and names the Parquet columns.
+
+MaxFileBytes starts a new file once the current one has at least that many bytes;
+0 writes a single file.  Compressed files are measured after compression, so they
+may pass MaxFileBytes by up to the compressor's buffer.
+*/
+type Options struct {
+	Compression  Compression
+	Directory    string
+	Format       Format
+	MaxFileBytes int64
+	Name         string
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	CompressionNone Compression = ""
+	CompressionGzip Compression = "gzip"
+	CompressionZstd Compression = "zstd"
+)
+
+const (
+	FormatCSV     Format = "csv"
+	FormatNDJSON  Format = "ndjson"
+	FormatParquet Format = "parquet"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var errPackage = errors.New("fileexport")
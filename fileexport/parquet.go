@@ -0,0 +1,344 @@
+package fileexport
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+parquetEncoder writes CSV rows as a Parquet file with one required UTF8 column per
+header field.  Rows are buffered into row groups of about parquetRowGroupBytes, and
+each column chunk of a row group is one PLAIN-encoded data page.
+*/
+type parquetEncoder struct {
+	buffered      *bufio.Writer
+	bufferedBytes int64
+	codec         int32
+	columns       []string
+	counter       *countingWriter
+	numRows       int64
+	rowGroupRows  int64
+	rowGroups     []parquetRowGroup
+	values        []bytes.Buffer
+	zstdEncoder   *zstd.Encoder
+}
+
+type parquetRowGroup struct {
+	columns       []parquetColumnChunk
+	numRows       int64
+	totalByteSize int64
+}
+
+type parquetColumnChunk struct {
+	compressedSize   int64
+	dataPageOffset   int64
+	uncompressedSize int64
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const parquetMagic = "PAR1"
+
+// Uncompressed bytes buffered before a row group is written.
+const parquetRowGroupBytes = 8 * 1024 * 1024
+
+// Parquet enumerations.
+const (
+	parquetCodecUncompressed  = 0
+	parquetCodecGzip          = 2
+	parquetCodecZstd          = 6
+	parquetConvertedUTF8      = 0
+	parquetEncodingPlain      = 0
+	parquetEncodingRle        = 3
+	parquetPageData           = 0
+	parquetRepetitionRequired = 0
+	parquetTypeByteArray      = 6
+	parquetVersion            = 1
+)
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (encoder *parquetEncoder) close() error {
+	if encoder.zstdEncoder != nil {
+		defer encoder.zstdEncoder.Close()
+	}
+
+	err := encoder.flushRowGroup()
+	if err != nil {
+		return err
+	}
+
+	footer := encoder.footer()
+
+	_, err = encoder.buffered.Write(footer)
+	if err != nil {
+		return wraperror.Errorf(err, "Write")
+	}
+
+	err = binary.Write(encoder.buffered, binary.LittleEndian, uint32(len(footer))) //nolint:gosec // Footers are small.
+	if err != nil {
+		return wraperror.Errorf(err, "Write")
+	}
+
+	_, err = encoder.buffered.WriteString(parquetMagic)
+	if err != nil {
+		return wraperror.Errorf(err, "WriteString")
+	}
+
+	return wraperror.Errorf(encoder.buffered.Flush(), "Flush")
+}
+
+func (encoder *parquetEncoder) size() int64 {
+	return encoder.counter.count + int64(encoder.buffered.Buffered()) + encoder.bufferedBytes
+}
+
+func (encoder *parquetEncoder) writeRow(row string) error {
+	fields, err := parseCsvRow(row)
+	if err != nil {
+		return err
+	}
+
+	if len(fields) != len(encoder.columns) {
+		return wraperror.Errorf(errPackage, "row has %d fields but the header has %d", len(fields), len(encoder.columns))
+	}
+
+	for index, field := range fields {
+		value := &encoder.values[index]
+		_ = binary.Write(value, binary.LittleEndian, uint32(len(field))) //nolint:gosec // CSV fields are small.
+		value.WriteString(field)
+
+		encoder.bufferedBytes += int64(4 + len(field)) //nolint:mnd // Length prefix.
+	}
+
+	encoder.rowGroupRows++
+
+	if encoder.bufferedBytes >= parquetRowGroupBytes {
+		return encoder.flushRowGroup()
+	}
+
+	return nil
+}
+
+// Write the buffered rows as a row group.
+func (encoder *parquetEncoder) flushRowGroup() error {
+	if encoder.rowGroupRows == 0 {
+		return nil
+	}
+
+	rowGroup := parquetRowGroup{numRows: encoder.rowGroupRows}
+
+	for index := range encoder.values {
+		columnChunk, err := encoder.writePage(encoder.values[index].Bytes(), encoder.rowGroupRows)
+		if err != nil {
+			return err
+		}
+
+		rowGroup.columns = append(rowGroup.columns, columnChunk)
+		rowGroup.totalByteSize += columnChunk.uncompressedSize
+		encoder.values[index].Reset()
+	}
+
+	encoder.rowGroups = append(encoder.rowGroups, rowGroup)
+	encoder.numRows += encoder.rowGroupRows
+	encoder.rowGroupRows = 0
+	encoder.bufferedBytes = 0
+
+	return nil
+}
+
+// Write one PLAIN data page holding all the values of a column chunk.
+func (encoder *parquetEncoder) writePage(values []byte, numValues int64) (parquetColumnChunk, error) {
+	result := parquetColumnChunk{dataPageOffset: encoder.counter.count + int64(encoder.buffered.Buffered())}
+
+	compressed, err := encoder.compress(values)
+	if err != nil {
+		return result, err
+	}
+
+	header := thriftWriter{}
+	header.structBegin()
+	header.i32Field(1, parquetPageData)
+	header.i32Field(2, int32(len(values)))     //nolint:gosec // Row groups are far below 2 GiB.
+	header.i32Field(3, int32(len(compressed))) //nolint:gosec // Row groups are far below 2 GiB.
+	header.structField(5)
+	header.i32Field(1, int32(numValues)) //nolint:gosec // Row groups are far below 2 GiB.
+	header.i32Field(2, parquetEncodingPlain)
+	header.i32Field(3, parquetEncodingRle)
+	header.i32Field(4, parquetEncodingRle)
+	header.structEnd()
+	header.structEnd()
+
+	_, err = encoder.buffered.Write(header.bytes())
+	if err != nil {
+		return result, wraperror.Errorf(err, "Write")
+	}
+
+	_, err = encoder.buffered.Write(compressed)
+	if err != nil {
+		return result, wraperror.Errorf(err, "Write")
+	}
+
+	result.compressedSize = int64(len(header.bytes()) + len(compressed))
+	result.uncompressedSize = int64(len(header.bytes()) + len(values))
+
+	return result, nil
+}
+
+func (encoder *parquetEncoder) compress(values []byte) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	switch encoder.codec {
+	case parquetCodecGzip:
+		compressor := gzip.NewWriter(&buffer)
+
+		_, err := compressor.Write(values)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "gzip.Write")
+		}
+
+		err = compressor.Close()
+		if err != nil {
+			return nil, wraperror.Errorf(err, "gzip.Close")
+		}
+
+		return buffer.Bytes(), nil
+	case parquetCodecZstd:
+		return encoder.zstdEncoder.EncodeAll(values, nil), nil
+	default:
+		return values, nil
+	}
+}
+
+// The FileMetaData of the file.
+func (encoder *parquetEncoder) footer() []byte {
+	footer := thriftWriter{}
+	footer.structBegin()
+	footer.i32Field(1, parquetVersion)
+
+	// The schema is a root element followed by the columns.
+
+	footer.listField(2, thriftStruct, len(encoder.columns)+1)
+	footer.structBegin()
+	footer.stringField(4, "schema")
+	footer.i32Field(5, int32(len(encoder.columns))) //nolint:gosec // CSV headers are small.
+	footer.structEnd()
+
+	for _, column := range encoder.columns {
+		footer.structBegin()
+		footer.i32Field(1, parquetTypeByteArray)
+		footer.i32Field(3, parquetRepetitionRequired)
+		footer.stringField(4, column)
+		footer.i32Field(6, parquetConvertedUTF8)
+		footer.structEnd()
+	}
+
+	footer.i64Field(3, encoder.numRows)
+	footer.listField(4, thriftStruct, len(encoder.rowGroups))
+
+	for _, rowGroup := range encoder.rowGroups {
+		footer.structBegin()
+		footer.listField(1, thriftStruct, len(rowGroup.columns))
+
+		for index, columnChunk := range rowGroup.columns {
+			footer.structBegin()
+			footer.i64Field(2, columnChunk.dataPageOffset)
+			footer.structField(3)
+			footer.i32Field(1, parquetTypeByteArray)
+			footer.i32ListField(2, parquetEncodingPlain, parquetEncodingRle)
+			footer.stringListField(3, encoder.columns[index])
+			footer.i32Field(4, encoder.codec)
+			footer.i64Field(5, rowGroup.numRows)
+			footer.i64Field(6, columnChunk.uncompressedSize)
+			footer.i64Field(7, columnChunk.compressedSize)
+			footer.i64Field(9, columnChunk.dataPageOffset)
+			footer.structEnd()
+			footer.structEnd()
+		}
+
+		footer.i64Field(2, rowGroup.totalByteSize)
+		footer.i64Field(3, rowGroup.numRows)
+		footer.structEnd()
+	}
+
+	footer.stringField(6, "serve-grpc")
+	footer.structEnd()
+
+	return footer.bytes()
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Start a Parquet file whose columns are named by the CSV header.
+func newParquetEncoder(counter *countingWriter, compression Compression, header string) (*parquetEncoder, error) {
+	columns, err := parseCsvRow(header)
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range columns {
+		columns[index] = strings.TrimSpace(columns[index])
+	}
+
+	result := &parquetEncoder{
+		buffered: bufio.NewWriterSize(counter, bufferSize),
+		codec:    parquetCodecUncompressed,
+		columns:  columns,
+		counter:  counter,
+		values:   make([]bytes.Buffer, len(columns)),
+	}
+
+	switch compression {
+	case CompressionGzip:
+		result.codec = parquetCodecGzip
+	case CompressionZstd:
+		result.codec = parquetCodecZstd
+
+		result.zstdEncoder, err = zstd.NewWriter(nil)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "zstd.NewWriter")
+		}
+	case CompressionNone:
+	}
+
+	_, err = result.buffered.WriteString(parquetMagic)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "WriteString")
+	}
+
+	return result, nil
+}
+
+func parseCsvRow(row string) ([]string, error) {
+	if len(row) == 0 {
+		return nil, nil
+	}
+
+	reader := csv.NewReader(strings.NewReader(row))
+	reader.LazyQuotes = true
+
+	result, err := reader.Read()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, wraperror.Errorf(err, "csv.Read")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,131 @@
+package fileexport
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+thriftWriter writes the Thrift compact protocol, which Parquet uses for page headers
+and the file footer.  Only the types Parquet metadata needs are supported.
+*/
+type thriftWriter struct {
+	buffer       bytes.Buffer
+	lastFieldID  int16
+	outerFieldID []int16
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Thrift compact protocol types.
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+const (
+	thriftMaxFieldDelta = 15
+	thriftMaxShortList  = 14
+)
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (thrift *thriftWriter) bytes() []byte {
+	return thrift.buffer.Bytes()
+}
+
+func (thrift *thriftWriter) fieldHeader(fieldID int16, fieldType byte) {
+	delta := fieldID - thrift.lastFieldID
+	if delta > 0 && delta <= thriftMaxFieldDelta {
+		thrift.buffer.WriteByte(byte(delta)<<4 | fieldType) //nolint:gosec // 1..15 fits in 4 bits.
+	} else {
+		thrift.buffer.WriteByte(fieldType)
+		thrift.varint(int64(fieldID))
+	}
+
+	thrift.lastFieldID = fieldID
+}
+
+func (thrift *thriftWriter) i32Field(fieldID int16, value int32) {
+	thrift.fieldHeader(fieldID, thriftI32)
+	thrift.varint(int64(value))
+}
+
+func (thrift *thriftWriter) i64Field(fieldID int16, value int64) {
+	thrift.fieldHeader(fieldID, thriftI64)
+	thrift.varint(value)
+}
+
+func (thrift *thriftWriter) stringField(fieldID int16, value string) {
+	thrift.fieldHeader(fieldID, thriftBinary)
+	thrift.string(value)
+}
+
+func (thrift *thriftWriter) i32ListField(fieldID int16, values ...int32) {
+	thrift.listField(fieldID, thriftI32, len(values))
+
+	for _, value := range values {
+		thrift.varint(int64(value))
+	}
+}
+
+func (thrift *thriftWriter) stringListField(fieldID int16, values ...string) {
+	thrift.listField(fieldID, thriftBinary, len(values))
+
+	for _, value := range values {
+		thrift.string(value)
+	}
+}
+
+// Begin a list field; the caller writes its elements.
+func (thrift *thriftWriter) listField(fieldID int16, elementType byte, size int) {
+	thrift.fieldHeader(fieldID, thriftList)
+
+	if size <= thriftMaxShortList {
+		thrift.buffer.WriteByte(byte(size)<<4 | elementType) //nolint:gosec // 0..14 fits in 4 bits.
+	} else {
+		thrift.buffer.WriteByte(0xf0 | elementType)
+		thrift.buffer.Write(binary.AppendUvarint(nil, uint64(size)))
+	}
+}
+
+// Begin a struct field; the caller writes its fields and calls structEnd.
+func (thrift *thriftWriter) structField(fieldID int16) {
+	thrift.fieldHeader(fieldID, thriftStruct)
+	thrift.structBegin()
+}
+
+// Begin a struct that is a list element or the outermost struct.
+func (thrift *thriftWriter) structBegin() {
+	thrift.outerFieldID = append(thrift.outerFieldID, thrift.lastFieldID)
+	thrift.lastFieldID = 0
+}
+
+func (thrift *thriftWriter) structEnd() {
+	thrift.buffer.WriteByte(0) // Stop.
+
+	last := len(thrift.outerFieldID) - 1
+	thrift.lastFieldID = thrift.outerFieldID[last]
+	thrift.outerFieldID = thrift.outerFieldID[:last]
+}
+
+func (thrift *thriftWriter) string(value string) {
+	thrift.buffer.Write(binary.AppendUvarint(nil, uint64(len(value))))
+	thrift.buffer.WriteString(value)
+}
+
+// Write a zigzag varint.
+func (thrift *thriftWriter) varint(value int64) {
+	thrift.buffer.Write(binary.AppendVarint(nil, value))
+}
@@ -0,0 +1,137 @@
+package grpcserver
+
+import (
+	"context"
+
+	szadminpb "github.com/senzing-garage/serve-grpc/proto/go/szadmin"
+	szjobpb "github.com/senzing-garage/serve-grpc/proto/go/szjob"
+	"github.com/senzing-garage/serve-grpc/szengineserver"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// adminServer implements the szadmin.SzAdmin service for a BasicGrpcServer.
+type adminServer struct {
+	szadminpb.UnimplementedSzAdminServer
+	grpcServer *BasicGrpcServer
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods for github.com/senzing-garage/serve-grpc/proto/go/szadmin.SzAdminServer
+// ----------------------------------------------------------------------------
+
+func (server *adminServer) GetExport(
+	ctx context.Context,
+	request *szadminpb.GetExportRequest,
+) (*szadminpb.ExportInfo, error) {
+	_ = ctx
+
+	exportInfo, isOpen := szengineserver.GetExport(request.GetExportId())
+	if !isOpen {
+		return nil, status.Errorf(codes.NotFound, "export %d is not open", request.GetExportId())
+	}
+
+	return exportInfoToProto(exportInfo), nil
+}
+
+func (server *adminServer) GetFileExport(
+	ctx context.Context,
+	request *szadminpb.GetFileExportRequest,
+) (*szadminpb.FileExportStatus, error) {
+	job, err := server.grpcServer.jobManager.get(ctx, request.GetJobId())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "file export %q does not exist", request.GetJobId())
+	}
+
+	return jobToFileExportStatus(job.snapshot())
+}
+
+func (server *adminServer) GetRedoStatus(
+	ctx context.Context,
+	request *szadminpb.GetRedoStatusRequest,
+) (*szadminpb.RedoStatusResponse, error) {
+	_ = request
+
+	return server.grpcServer.getRedoStatus(ctx), nil
+}
+
+func (server *adminServer) ListExports(
+	ctx context.Context,
+	request *szadminpb.ListExportsRequest,
+) (*szadminpb.ListExportsResponse, error) {
+	_ = ctx
+	_ = request
+
+	result := &szadminpb.ListExportsResponse{}
+
+	for _, exportInfo := range szengineserver.ListExports() {
+		result.Exports = append(result.Exports, exportInfoToProto(exportInfo))
+	}
+
+	return result, nil
+}
+
+func (server *adminServer) PauseRedo(
+	ctx context.Context,
+	request *szadminpb.PauseRedoRequest,
+) (*szadminpb.RedoStatusResponse, error) {
+	_ = request
+
+	if server.grpcServer.redoProcessor == nil {
+		return nil, redoDisabledError()
+	}
+
+	server.grpcServer.pauseRedo(ctx)
+
+	return server.grpcServer.getRedoStatus(ctx), nil
+}
+
+func (server *adminServer) ResumeRedo(
+	ctx context.Context,
+	request *szadminpb.ResumeRedoRequest,
+) (*szadminpb.RedoStatusResponse, error) {
+	_ = request
+
+	if server.grpcServer.redoProcessor == nil {
+		return nil, redoDisabledError()
+	}
+
+	server.grpcServer.resumeRedo(ctx)
+
+	return server.grpcServer.getRedoStatus(ctx), nil
+}
+
+func (server *adminServer) StartFileExport(
+	ctx context.Context,
+	request *szadminpb.StartFileExportRequest,
+) (*szadminpb.FileExportStatus, error) {
+	job, err := server.grpcServer.jobManager.submit(ctx, &szjobpb.SubmitJobRequest{
+		Operation: &szjobpb.SubmitJobRequest_ExportFile{ExportFile: request},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return jobToFileExportStatus(job.snapshot())
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Add SzAdmin service to gRPC server.
+func (grpcServer *BasicGrpcServer) enableSzAdmin() {
+	szadminpb.RegisterSzAdminServer(grpcServer.grpcserver, &adminServer{grpcServer: grpcServer})
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func redoDisabledError() error {
+	return status.Error(codes.FailedPrecondition, "redo processing is not enabled on this server")
+}
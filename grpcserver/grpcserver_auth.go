@@ -0,0 +1,180 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+An Authenticator identifies the caller of an RPC from the incoming metadata or the
+TLS connection.  grpc-web requests are handled by grpc.Server.ServeHTTP, which turns
+HTTP headers into metadata, so one Authenticator serves both transports.
+*/
+type Authenticator interface {
+	/*
+		Authenticate returns the Principal for the credentials found in ctx.
+		It returns ErrNoCredentials if ctx holds no credentials of the kind it handles,
+		and another error if the credentials are present but not valid.
+	*/
+	Authenticate(ctx context.Context) (*Principal, error)
+}
+
+// A Principal is an authenticated caller.
+type Principal struct {
+	AuthenticationMethod string   // One of the AuthenticationMethod* constants.
+	Name                 string   // API key name, JWT "sub" claim or client certificate subject.
+	Roles                []string // Roles from the API key file or the JWT "roles" claim.
+}
+
+// principalContextKey is the context key of the *Principal.
+type principalContextKey struct{}
+
+// contextServerStream replaces the context of a server stream, e.g. with one holding the Principal.
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context //nolint:containedctx // grpc.ServerStream.Context() must return it.
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Values of Principal.AuthenticationMethod.
+const (
+	AuthenticationMethodAPIKey = "api-key"
+	AuthenticationMethodJWT    = "jwt"
+	AuthenticationMethodMTLS   = "mtls"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// ErrNoCredentials is returned by an Authenticator when the call carries no credentials it handles.
+var ErrNoCredentials = errors.New("no credentials")
+
+// Services that may be called without credentials, so that load balancers can probe them.
+var unauthenticatedServices = map[string]bool{
+	healthpb.Health_ServiceDesc.ServiceName: true,
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The ContextWithPrincipal function returns a copy of ctx holding principal.
+
+Input
+  - ctx: A context to control lifecycle.
+  - principal: The authenticated caller.
+
+Output
+  - A context from which PrincipalFromContext returns principal.
+*/
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+/*
+The PrincipalFromContext function returns the caller authenticated by BasicGrpcServer.
+
+Input
+  - ctx: The context of an RPC.
+
+Output
+  - The Principal, and true if the RPC was authenticated.
+*/
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, isPrincipal := ctx.Value(principalContextKey{}).(*Principal)
+
+	return principal, isPrincipal && principal != nil
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+// Context returns the replacement stream context.
+func (stream *contextServerStream) Context() context.Context {
+	return stream.ctx
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Reject unary calls without valid credentials.
+func (grpcServer *BasicGrpcServer) authUnaryInterceptor(
+	ctx context.Context,
+	request any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	principalCtx, err := grpcServer.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(principalCtx, request)
+}
+
+// Reject streams without valid credentials.
+func (grpcServer *BasicGrpcServer) authStreamInterceptor(
+	server any,
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	principalCtx, err := grpcServer.authenticate(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(server, &contextServerStream{ServerStream: stream, ctx: principalCtx})
+}
+
+// Ask each Authenticator in turn; the first one that recognizes the credentials decides.
+func (grpcServer *BasicGrpcServer) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	if len(grpcServer.Authenticators) == 0 {
+		return ctx, nil
+	}
+
+	service, _ := splitFullMethod(fullMethod)
+	if unauthenticatedServices[service] {
+		return ctx, nil
+	}
+
+	for _, authenticator := range grpcServer.Authenticators {
+		principal, err := authenticator.Authenticate(ctx)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+
+		if err != nil {
+			grpcServer.log(3002, fullMethod, err)
+
+			return ctx, status.Error(codes.Unauthenticated, "invalid credentials")
+		}
+
+		grpcServer.log(1002, fullMethod, principal.AuthenticationMethod, principal.Name)
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String("enduser.id", principal.Name))
+
+		return ContextWithPrincipal(ctx, principal), nil
+	}
+
+	grpcServer.log(3002, fullMethod, ErrNoCredentials)
+
+	return ctx, status.Error(codes.Unauthenticated, "credentials required")
+}
@@ -0,0 +1,247 @@
+package grpcserver_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/senzing-garage/serve-grpc/grpcserver"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	testAPIKey     = "test-api-key"
+	testHMACKey    = "0123456789abcdef0123456789abcdef"
+	testJWTKeyID   = "test-key"
+	testJWTIssuer  = "https://issuer.example.com"
+	testClientCert = "../testdata/certificates/client/certificate.pem"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestAPIKeyAuthenticator_Authenticate(test *testing.T) {
+	ctx := test.Context()
+	authenticator, err := grpcserver.NewAPIKeyAuthenticator(writeAPIKeysFile(test))
+	require.NoError(test, err)
+
+	principal, err := authenticator.Authenticate(incomingContext(ctx, "x-api-key", testAPIKey))
+	require.NoError(test, err)
+	require.Equal(test, grpcserver.AuthenticationMethodAPIKey, principal.AuthenticationMethod)
+	require.Equal(test, "loader", principal.Name)
+	require.Equal(test, []string{"writer"}, principal.Roles)
+
+	_, err = authenticator.Authenticate(incomingContext(ctx, "x-api-key", "wrong-key"))
+	require.Error(test, err)
+	require.NotErrorIs(test, err, grpcserver.ErrNoCredentials)
+
+	_, err = authenticator.Authenticate(ctx)
+	require.ErrorIs(test, err, grpcserver.ErrNoCredentials)
+}
+
+func TestNewAPIKeyAuthenticator_badFile(test *testing.T) {
+	_, err := grpcserver.NewAPIKeyAuthenticator(filepath.Join(test.TempDir(), "missing.json"))
+	require.Error(test, err)
+
+	filename := filepath.Join(test.TempDir(), "api-keys.json")
+	require.NoError(test, os.WriteFile(filename, []byte(`[{"name": "no-key"}]`), 0o600))
+	_, err = grpcserver.NewAPIKeyAuthenticator(filename)
+	require.Error(test, err)
+}
+
+func TestJWTAuthenticator_Authenticate(test *testing.T) {
+	ctx := test.Context()
+	authenticator, err := grpcserver.NewJWTAuthenticator(writeJWKSFile(test), testJWTIssuer, "serve-grpc")
+	require.NoError(test, err)
+
+	token := signJWT(test, jwt.Claims{
+		Issuer:   testJWTIssuer,
+		Subject:  "alice",
+		Audience: jwt.Audience{"serve-grpc"},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	})
+
+	principal, err := authenticator.Authenticate(incomingContext(ctx, "authorization", "Bearer "+token))
+	require.NoError(test, err)
+	require.Equal(test, grpcserver.AuthenticationMethodJWT, principal.AuthenticationMethod)
+	require.Equal(test, "alice", principal.Name)
+	require.Equal(test, []string{"admin"}, principal.Roles)
+
+	_, err = authenticator.Authenticate(ctx)
+	require.ErrorIs(test, err, grpcserver.ErrNoCredentials)
+}
+
+func TestJWTAuthenticator_Authenticate_rsa(test *testing.T) {
+	ctx := test.Context()
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(test, err)
+
+	keySet := jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{
+			{Key: &privateKey.PublicKey, KeyID: "rsa-key", Algorithm: string(jose.RS256)},
+		},
+	}
+	fileBytes, err := json.Marshal(keySet)
+	require.NoError(test, err)
+	filename := filepath.Join(test.TempDir(), "jwks.json")
+	require.NoError(test, os.WriteFile(filename, fileBytes, 0o600))
+
+	authenticator, err := grpcserver.NewJWTAuthenticator(filename, "", "")
+	require.NoError(test, err)
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: privateKey},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader(jose.HeaderKey("kid"), "rsa-key"),
+	)
+	require.NoError(test, err)
+	token, err := jwt.Signed(signer).Claims(jwt.Claims{Subject: "bob"}).Serialize()
+	require.NoError(test, err)
+
+	principal, err := authenticator.Authenticate(incomingContext(ctx, "authorization", "Bearer "+token))
+	require.NoError(test, err)
+	require.Equal(test, "bob", principal.Name)
+}
+
+func TestJWTAuthenticator_Authenticate_expired(test *testing.T) {
+	ctx := test.Context()
+	authenticator, err := grpcserver.NewJWTAuthenticator(writeJWKSFile(test), "", "")
+	require.NoError(test, err)
+
+	token := signJWT(test, jwt.Claims{
+		Subject: "alice",
+		Expiry:  jwt.NewNumericDate(time.Now().Add(-time.Hour)),
+	})
+
+	_, err = authenticator.Authenticate(incomingContext(ctx, "authorization", "Bearer "+token))
+	require.Error(test, err)
+	require.NotErrorIs(test, err, grpcserver.ErrNoCredentials)
+}
+
+func TestJWTAuthenticator_Authenticate_badIssuer(test *testing.T) {
+	ctx := test.Context()
+	authenticator, err := grpcserver.NewJWTAuthenticator(writeJWKSFile(test), testJWTIssuer, "")
+	require.NoError(test, err)
+
+	token := signJWT(test, jwt.Claims{
+		Issuer:  "https://other.example.com",
+		Subject: "alice",
+	})
+
+	_, err = authenticator.Authenticate(incomingContext(ctx, "authorization", "Bearer "+token))
+	require.Error(test, err)
+}
+
+func TestJWTAuthenticator_Authenticate_badSignature(test *testing.T) {
+	ctx := test.Context()
+	authenticator, err := grpcserver.NewJWTAuthenticator(writeJWKSFile(test), "", "")
+	require.NoError(test, err)
+
+	token := signJWT(test, jwt.Claims{Subject: "alice"})
+	tampered := token[:len(token)-2] + "AA"
+
+	_, err = authenticator.Authenticate(incomingContext(ctx, "authorization", "Bearer "+tampered))
+	require.Error(test, err)
+}
+
+func TestMTLSAuthenticator_Authenticate(test *testing.T) {
+	ctx := test.Context()
+	authenticator := &grpcserver.MTLSAuthenticator{}
+
+	_, err := authenticator.Authenticate(ctx)
+	require.ErrorIs(test, err, grpcserver.ErrNoCredentials)
+
+	pemBytes, err := os.ReadFile(testClientCert)
+	require.NoError(test, err)
+	block, _ := pem.Decode(pemBytes)
+	require.NotNil(test, block)
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(test, err)
+
+	peerCtx := peer.NewContext(ctx, &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				VerifiedChains: [][]*x509.Certificate{{certificate}},
+			},
+		},
+	})
+
+	principal, err := authenticator.Authenticate(peerCtx)
+	require.NoError(test, err)
+	require.Equal(test, grpcserver.AuthenticationMethodMTLS, principal.AuthenticationMethod)
+	require.Equal(test, certificate.Subject.String(), principal.Name)
+}
+
+func TestPrincipalFromContext(test *testing.T) {
+	ctx := test.Context()
+	_, isAuthenticated := grpcserver.PrincipalFromContext(ctx)
+	require.False(test, isAuthenticated)
+
+	principal := &grpcserver.Principal{Name: "alice"}
+	principal2, isAuthenticated := grpcserver.PrincipalFromContext(grpcserver.ContextWithPrincipal(ctx, principal))
+	require.True(test, isAuthenticated)
+	require.Same(test, principal, principal2)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func incomingContext(ctx context.Context, key string, value string) context.Context {
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(key, value))
+}
+
+func signJWT(test *testing.T, claims jwt.Claims) string {
+	test.Helper()
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.HS256, Key: []byte(testHMACKey)},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader(jose.HeaderKey("kid"), testJWTKeyID),
+	)
+	require.NoError(test, err)
+
+	token, err := jwt.Signed(signer).Claims(claims).Claims(map[string]any{"roles": []string{"admin"}}).Serialize()
+	require.NoError(test, err)
+
+	return token
+}
+
+func writeAPIKeysFile(test *testing.T) string {
+	test.Helper()
+
+	filename := filepath.Join(test.TempDir(), "api-keys.json")
+	fileBytes := []byte(`[{"name": "loader", "key": "` + testAPIKey + `", "roles": ["writer"]}]`)
+	require.NoError(test, os.WriteFile(filename, fileBytes, 0o600))
+
+	return filename
+}
+
+func writeJWKSFile(test *testing.T) string {
+	test.Helper()
+
+	keySet := jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{
+			{Key: []byte(testHMACKey), KeyID: testJWTKeyID, Algorithm: string(jose.HS256)},
+		},
+	}
+	fileBytes, err := json.Marshal(keySet)
+	require.NoError(test, err)
+
+	filename := filepath.Join(test.TempDir(), "jwks.json")
+	require.NoError(test, os.WriteFile(filename, fileBytes, 0o600))
+
+	return filename
+}
@@ -0,0 +1,319 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+APIKeyAuthenticator accepts the static API keys listed in a JSON file, sent in the
+"x-api-key" metadata (HTTP header for grpc-web).  The file holds a list of
+
+	{"name": "batch-loader", "key": "...", "roles": ["loader"]}
+*/
+type APIKeyAuthenticator struct {
+	principals map[[sha256.Size]byte]*Principal
+}
+
+/*
+JWTAuthenticator accepts "Bearer" JWTs in the "authorization" metadata, signed with a
+key from a local JWKS file.  HMAC keys ("kty": "oct") and RSA public keys are
+supported.  The "sub" claim names the Principal and the "roles" claim lists its roles.
+*/
+type JWTAuthenticator struct {
+	audience string
+	issuer   string
+	keySet   jose.JSONWebKeySet
+}
+
+/*
+MTLSAuthenticator identifies callers by the subject of their verified client
+certificate.  It requires the server to verify client certificates against a CA.
+*/
+type MTLSAuthenticator struct{}
+
+type apiKeyEntry struct {
+	Key   string   `json:"key"`
+	Name  string   `json:"name"`
+	Roles []string `json:"roles"`
+}
+
+type jwtRolesClaim struct {
+	Roles []string `json:"roles"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	apiKeyMetadataKey        = "x-api-key"
+	authorizationMetadataKey = "authorization"
+	bearerPrefix             = "bearer "
+	jwtLeeway                = time.Minute
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var jwtSignatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.HS256, jose.HS384, jose.HS512,
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewAPIKeyAuthenticator function reads the API keys file.
+
+Input
+  - filename: Path of a JSON file holding a list of {"name", "key", "roles"} objects.
+
+Output
+  - An APIKeyAuthenticator.
+*/
+func NewAPIKeyAuthenticator(filename string) (*APIKeyAuthenticator, error) {
+	var entries []apiKeyEntry
+
+	fileBytes, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "os.ReadFile %s", filename)
+	}
+
+	err = json.Unmarshal(fileBytes, &entries)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal %s", filename)
+	}
+
+	result := &APIKeyAuthenticator{
+		principals: make(map[[sha256.Size]byte]*Principal, len(entries)),
+	}
+
+	for index, entry := range entries {
+		if len(entry.Key) == 0 || len(entry.Name) == 0 {
+			return nil, wraperror.Errorf(errForPackage, "%s: entry %d needs both name and key", filename, index)
+		}
+
+		result.principals[sha256.Sum256([]byte(entry.Key))] = &Principal{
+			AuthenticationMethod: AuthenticationMethodAPIKey,
+			Name:                 entry.Name,
+			Roles:                entry.Roles,
+		}
+	}
+
+	return result, nil
+}
+
+/*
+The NewJWTAuthenticator function reads the JWKS file.
+
+Input
+  - filename: Path of a JSON Web Key Set file.
+  - issuer: If not empty, the required "iss" claim.
+  - audience: If not empty, a required member of the "aud" claim.
+
+Output
+  - A JWTAuthenticator.
+*/
+func NewJWTAuthenticator(filename string, issuer string, audience string) (*JWTAuthenticator, error) {
+	result := &JWTAuthenticator{
+		audience: audience,
+		issuer:   issuer,
+	}
+
+	fileBytes, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "os.ReadFile %s", filename)
+	}
+
+	err = json.Unmarshal(fileBytes, &result.keySet)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal %s", filename)
+	}
+
+	if len(result.keySet.Keys) == 0 {
+		return nil, wraperror.Errorf(errForPackage, "%s holds no keys", filename)
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+The Authenticate method looks up the "x-api-key" metadata.
+
+Input
+  - ctx: The context of an RPC.
+
+Output
+  - The Principal of the key; ErrNoCredentials if no key was sent.
+*/
+func (authenticator *APIKeyAuthenticator) Authenticate(ctx context.Context) (*Principal, error) {
+	apiKey := firstMetadataValue(ctx, apiKeyMetadataKey)
+	if len(apiKey) == 0 {
+		return nil, ErrNoCredentials
+	}
+
+	// Keys are looked up by digest, so the lookup time does not depend on how much of a key matches.
+
+	principal, isKnown := authenticator.principals[sha256.Sum256([]byte(apiKey))]
+	if !isKnown {
+		return nil, wraperror.Errorf(errForPackage, "unknown API key")
+	}
+
+	return principal, nil
+}
+
+/*
+The Authenticate method verifies the "Bearer" JWT in the "authorization" metadata.
+
+Input
+  - ctx: The context of an RPC.
+
+Output
+  - The Principal named by the "sub" claim; ErrNoCredentials if no bearer token was sent.
+*/
+func (authenticator *JWTAuthenticator) Authenticate(ctx context.Context) (*Principal, error) {
+	authorization := firstMetadataValue(ctx, authorizationMetadataKey)
+	if len(authorization) < len(bearerPrefix) || !strings.EqualFold(authorization[:len(bearerPrefix)], bearerPrefix) {
+		return nil, ErrNoCredentials
+	}
+
+	token, err := jwt.ParseSigned(strings.TrimSpace(authorization[len(bearerPrefix):]), jwtSignatureAlgorithms)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "jwt.ParseSigned")
+	}
+
+	claims, roles, err := authenticator.verify(token)
+	if err != nil {
+		return nil, err
+	}
+
+	err = claims.ValidateWithLeeway(jwt.Expected{
+		Issuer:      authenticator.issuer,
+		AnyAudience: audienceOrNil(authenticator.audience),
+		Time:        time.Now(),
+	}, jwtLeeway)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "ValidateWithLeeway")
+	}
+
+	if len(claims.Subject) == 0 {
+		return nil, wraperror.Errorf(errForPackage, "JWT has no sub claim")
+	}
+
+	return &Principal{
+		AuthenticationMethod: AuthenticationMethodJWT,
+		Name:                 claims.Subject,
+		Roles:                roles.Roles,
+	}, nil
+}
+
+/*
+The Authenticate method reads the subject of the verified client certificate.
+
+Input
+  - ctx: The context of an RPC.
+
+Output
+  - The Principal named by the certificate subject; ErrNoCredentials if there is no verified client certificate.
+*/
+func (authenticator *MTLSAuthenticator) Authenticate(ctx context.Context) (*Principal, error) {
+	_ = authenticator
+
+	callerPeer, isPeer := peer.FromContext(ctx)
+	if !isPeer {
+		return nil, ErrNoCredentials
+	}
+
+	tlsInfo, isTLS := callerPeer.AuthInfo.(credentials.TLSInfo)
+	if !isTLS || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, ErrNoCredentials
+	}
+
+	return &Principal{
+		AuthenticationMethod: AuthenticationMethodMTLS,
+		Name:                 tlsInfo.State.VerifiedChains[0][0].Subject.String(),
+	}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Check the signature with the key named by "kid", or with every key if the token has no "kid".
+func (authenticator *JWTAuthenticator) verify(token *jwt.JSONWebToken) (jwt.Claims, jwtRolesClaim, error) {
+	var (
+		claims jwt.Claims
+		roles  jwtRolesClaim
+	)
+
+	header := token.Headers[0]
+	keys := authenticator.keySet.Keys
+
+	if len(header.KeyID) > 0 {
+		keys = authenticator.keySet.Key(header.KeyID)
+	}
+
+	for _, key := range keys {
+		if len(key.Algorithm) > 0 && key.Algorithm != header.Algorithm {
+			continue
+		}
+
+		verificationKey := key.Key
+		if !key.IsPublic() {
+			if publicKey := key.Public(); publicKey.Valid() {
+				verificationKey = publicKey.Key
+			}
+		}
+
+		if token.Claims(verificationKey, &claims, &roles) == nil {
+			return claims, roles, nil
+		}
+	}
+
+	return claims, roles, wraperror.Errorf(errForPackage, "JWT signature not verified by any key in the JWKS")
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func audienceOrNil(audience string) []string {
+	if len(audience) == 0 {
+		return nil
+	}
+
+	return []string{audience}
+}
+
+func firstMetadataValue(ctx context.Context, key string) string {
+	values := metadata.ValueFromIncomingContext(ctx, key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
@@ -0,0 +1,324 @@
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/serve-grpc/proto/go/szbatch"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+An AccessPolicy decides which principals may call which gRPC methods, and with which
+data sources.  It is read from a JSON file such as
+
+	{
+	  "principals": {"CN=loader,O=Example": ["loader"]},
+	  "rules": [
+	    {"roles": ["admin"], "methods": ["/szconfigmanager.SzConfigManager/*", "/szdiagnostic.SzDiagnostic/*",
+	                                    "/szengine.SzEngine/*"]},
+	    {"roles": ["analyst"], "methods": ["/szengine.SzEngine/Get*", "/szengine.SzEngine/Find*",
+	                                      "/szengine.SzEngine/Why*", "/szengine.SzEngine/Search*"]},
+	    {"roles": ["loader"], "methods": ["/szengine.SzEngine/AddRecord"], "dataSources": ["CUSTOMERS"]}
+	  ]
+	}
+
+"principals" grants roles to principal names in addition to the roles carried by
+their credentials.  A call is allowed if a rule lists one of the caller's roles (or
+"*" for any caller) and a method pattern (path.Match syntax) matching the full method
+name.  If every matching rule lists "dataSources", each data source code in each
+request message must be one of them.
+*/
+type AccessPolicy struct {
+	Principals map[string][]string `json:"principals"`
+	Rules      []AccessRule        `json:"rules"`
+}
+
+// An AccessRule allows principals having one of Roles to call Methods, optionally only for DataSources.
+type AccessRule struct {
+	DataSources []string `json:"dataSources,omitempty"`
+	Methods     []string `json:"methods"`
+	Roles       []string `json:"roles"`
+}
+
+// accessGrant is the outcome of matching a principal and method against an AccessPolicy.
+type accessGrant struct {
+	allowed     bool
+	dataSources map[string]bool // nil if any data source is allowed.
+}
+
+// authzServerStream checks the data sources of every message received on a stream.
+type authzServerStream struct {
+	grpc.ServerStream
+	fullMethod string
+	grant      accessGrant
+	grpcServer *BasicGrpcServer
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const anyRole = "*"
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewAccessPolicy function reads an AccessPolicy from a JSON file.
+
+Input
+  - filename: Path of the policy file.
+
+Output
+  - An AccessPolicy.
+*/
+func NewAccessPolicy(filename string) (*AccessPolicy, error) {
+	result := &AccessPolicy{}
+
+	fileBytes, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "os.ReadFile %s", filename)
+	}
+
+	err = json.Unmarshal(fileBytes, result)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal %s", filename)
+	}
+
+	for index, rule := range result.Rules {
+		for _, method := range rule.Methods {
+			_, err = path.Match(method, "")
+			if err != nil {
+				return nil, wraperror.Errorf(err, "%s: rule %d has bad method pattern %q", filename, index, method)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+// RecvMsg rejects messages naming a data source the caller may not use.
+func (stream *authzServerStream) RecvMsg(message any) error {
+	err := stream.ServerStream.RecvMsg(message)
+	if err != nil {
+		return err //nolint:wrapcheck // io.EOF must reach the handler unwrapped.
+	}
+
+	return stream.grpcServer.checkDataSources(stream.Context(), stream.fullMethod, stream.grant, message)
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Match the caller against the rules for fullMethod.
+func (policy *AccessPolicy) grant(principal *Principal, fullMethod string) accessGrant {
+	var result accessGrant
+
+	roles := policy.roles(principal)
+
+	for _, rule := range policy.Rules {
+		if !rule.appliesTo(roles, fullMethod) {
+			continue
+		}
+
+		if len(rule.DataSources) == 0 {
+			return accessGrant{allowed: true}
+		}
+
+		if result.dataSources == nil {
+			result.dataSources = map[string]bool{}
+		}
+
+		for _, dataSource := range rule.DataSources {
+			result.dataSources[strings.ToUpper(dataSource)] = true
+		}
+
+		result.allowed = true
+	}
+
+	return result
+}
+
+// The roles from the caller's credentials plus those granted by "principals".
+func (policy *AccessPolicy) roles(principal *Principal) []string {
+	if principal == nil {
+		return nil
+	}
+
+	return append(slices.Clone(principal.Roles), policy.Principals[principal.Name]...)
+}
+
+func (rule *AccessRule) appliesTo(roles []string, fullMethod string) bool {
+	if !slices.Contains(rule.Roles, anyRole) && !slices.ContainsFunc(roles, func(role string) bool {
+		return slices.Contains(rule.Roles, role)
+	}) {
+		return false
+	}
+
+	return slices.ContainsFunc(rule.Methods, func(pattern string) bool {
+		isMatch, err := path.Match(pattern, fullMethod)
+
+		return err == nil && isMatch
+	})
+}
+
+// Enforce the AccessPolicy on unary calls.
+func (grpcServer *BasicGrpcServer) authzUnaryInterceptor(
+	ctx context.Context,
+	request any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	grant, err := grpcServer.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	err = grpcServer.checkDataSources(ctx, info.FullMethod, grant, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, request)
+}
+
+// Enforce the AccessPolicy on streams, checking each message received.
+func (grpcServer *BasicGrpcServer) authzStreamInterceptor(
+	server any,
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	grant, err := grpcServer.authorize(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	if grant.dataSources == nil {
+		return handler(server, stream)
+	}
+
+	return handler(server, &authzServerStream{
+		ServerStream: stream,
+		fullMethod:   info.FullMethod,
+		grant:        grant,
+		grpcServer:   grpcServer,
+	})
+}
+
+// Decide if the caller may call fullMethod at all.
+func (grpcServer *BasicGrpcServer) authorize(ctx context.Context, fullMethod string) (accessGrant, error) {
+	if grpcServer.AccessPolicy == nil {
+		return accessGrant{allowed: true}, nil
+	}
+
+	service, _ := splitFullMethod(fullMethod)
+	if unauthenticatedServices[service] {
+		return accessGrant{allowed: true}, nil
+	}
+
+	principal, _ := PrincipalFromContext(ctx)
+
+	grant := grpcServer.AccessPolicy.grant(principal, fullMethod)
+	if !grant.allowed {
+		return grant, grpcServer.deny(principal, fullMethod, "no rule allows the method")
+	}
+
+	return grant, nil
+}
+
+// Apply the AccessPolicy to work done for the caller of ctx as if it called fullMethod with request.
+func (grpcServer *BasicGrpcServer) authorizeCall(ctx context.Context, fullMethod string, request any) error {
+	grant, err := grpcServer.authorize(ctx, fullMethod)
+	if err != nil {
+		return err
+	}
+
+	return grpcServer.checkDataSources(ctx, fullMethod, grant, request)
+}
+
+// Decide if the data sources named in message are allowed by grant.
+func (grpcServer *BasicGrpcServer) checkDataSources(
+	ctx context.Context,
+	fullMethod string,
+	grant accessGrant,
+	message any,
+) error {
+	if grant.dataSources == nil {
+		return nil
+	}
+
+	principal, _ := PrincipalFromContext(ctx)
+
+	dataSources := requestDataSources(message)
+	if len(dataSources) == 0 {
+		return grpcServer.deny(principal, fullMethod, "the method is only allowed for specific data sources")
+	}
+
+	for _, dataSource := range dataSources {
+		if !grant.dataSources[strings.ToUpper(dataSource)] {
+			return grpcServer.deny(principal, fullMethod, "data source "+dataSource+" is not allowed")
+		}
+	}
+
+	return nil
+}
+
+// Audit-log a denial and build the PermissionDenied status.
+func (grpcServer *BasicGrpcServer) deny(principal *Principal, fullMethod string, reason string) error {
+	principalName := "<unauthenticated>"
+	if principal != nil {
+		principalName = principal.Name
+	}
+
+	grpcServer.log(3003, fullMethod, principalName, reason)
+
+	return status.Errorf(codes.PermissionDenied, "%s: %s", fullMethod, reason)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// The data source codes named by a request's DataSourceCode fields, including those of its record keys.
+func requestDataSources(request any) []string {
+	var result []string
+
+	if getter, ok := request.(interface{ GetDataSourceCode() string }); ok {
+		result = append(result, getter.GetDataSourceCode())
+	}
+
+	if getter, ok := request.(interface{ GetDataSourceCode_1() string }); ok {
+		result = append(result, getter.GetDataSourceCode_1())
+	}
+
+	if getter, ok := request.(interface{ GetDataSourceCode_2() string }); ok {
+		result = append(result, getter.GetDataSourceCode_2())
+	}
+
+	if getter, ok := request.(interface{ GetRecordKeys() []*szbatch.RecordKey }); ok {
+		for _, recordKey := range getter.GetRecordKeys() {
+			result = append(result, recordKey.GetDataSourceCode())
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,99 @@
+package grpcserver_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/senzing-garage/go-helpers/settings"
+	"github.com/senzing-garage/serve-grpc/grpcserver"
+	szenginepb "github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	szproductpb "github.com/senzing-garage/sz-sdk-proto/go/szproduct"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testAccessPolicy = `{
+	"principals": {"loader": ["analyst"]},
+	"rules": [
+		{"roles": ["admin"], "methods": ["/*/*"]},
+		{"roles": ["analyst"], "methods": ["/szproduct.SzProduct/Get*"]},
+		{"roles": ["writer"], "methods": ["/szengine.SzEngine/GetRecord"], "dataSources": ["customers"]}
+	]
+}`
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestNewAccessPolicy(test *testing.T) {
+	accessPolicy, err := grpcserver.NewAccessPolicy(writeTestFile(test, "access-policy.json", testAccessPolicy))
+	require.NoError(test, err)
+	require.Len(test, accessPolicy.Rules, 3)
+	require.Equal(test, []string{"analyst"}, accessPolicy.Principals["loader"])
+}
+
+func TestNewAccessPolicy_badFile(test *testing.T) {
+	_, err := grpcserver.NewAccessPolicy(filepath.Join(test.TempDir(), "missing.json"))
+	require.Error(test, err)
+
+	_, err = grpcserver.NewAccessPolicy(writeTestFile(test, "access-policy.json", `{"rules": [`))
+	require.Error(test, err)
+
+	_, err = grpcserver.NewAccessPolicy(writeTestFile(test, "access-policy.json", `{"rules": [{"roles": ["admin"], "methods": ["/sz["]}]}`))
+	require.Error(test, err)
+}
+
+func TestGrpcServerImpl_AccessPolicy(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	authenticator, err := grpcserver.NewAPIKeyAuthenticator(writeAPIKeysFile(test))
+	require.NoError(test, err)
+
+	accessPolicy, err := grpcserver.NewAccessPolicy(writeTestFile(test, "access-policy.json", testAccessPolicy))
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AccessPolicy:        accessPolicy,
+		Authenticators:      []grpcserver.Authenticator{authenticator},
+		AvoidServing:        true,
+		EnableSzEngine:      true,
+		EnableSzProduct:     true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	clientConn := serveBufconn(test, grpcServer)
+	apiKeyCtx := metadata.AppendToOutgoingContext(ctx, "x-api-key", testAPIKey)
+
+	// The "loader" API key has the "writer" role, and the policy adds "analyst".
+
+	productClient := szproductpb.NewSzProductClient(clientConn)
+	_, err = productClient.GetVersion(apiKeyCtx, &szproductpb.GetVersionRequest{})
+	require.NoError(test, err)
+
+	engineClient := szenginepb.NewSzEngineClient(clientConn)
+	_, err = engineClient.GetEntityByEntityId(apiKeyCtx, &szenginepb.GetEntityByEntityIdRequest{EntityId: 1})
+	require.Equal(test, codes.PermissionDenied, status.Code(err))
+
+	_, err = engineClient.GetRecord(apiKeyCtx, &szenginepb.GetRecordRequest{DataSourceCode: "WATCHLIST", RecordId: "1"})
+	require.Equal(test, codes.PermissionDenied, status.Code(err))
+
+	_, err = engineClient.GetRecord(apiKeyCtx, &szenginepb.GetRecordRequest{DataSourceCode: "CUSTOMERS", RecordId: "1"})
+	require.NotEqual(test, codes.PermissionDenied, status.Code(err))
+
+	// Health checks need no roles.
+
+	_, err = healthpb.NewHealthClient(clientConn).Check(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(test, err)
+}
@@ -2,10 +2,13 @@ package grpcserver
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/url"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/senzing-garage/go-cmdhelping/option"
@@ -15,10 +18,16 @@ import (
 	"github.com/senzing-garage/go-observing/observer"
 	"github.com/senzing-garage/go-observing/observerpb"
 	"github.com/senzing-garage/init-database/initializer"
+	"github.com/senzing-garage/serve-grpc/proto/go/szbatch"
+	"github.com/senzing-garage/serve-grpc/proto/go/szbulk"
+	"github.com/senzing-garage/serve-grpc/proto/go/szexport"
+	"github.com/senzing-garage/serve-grpc/szbatchserver"
+	"github.com/senzing-garage/serve-grpc/szbulkserver"
 	"github.com/senzing-garage/serve-grpc/szconfigmanagerserver"
 	"github.com/senzing-garage/serve-grpc/szconfigserver"
 	"github.com/senzing-garage/serve-grpc/szdiagnosticserver"
 	"github.com/senzing-garage/serve-grpc/szengineserver"
+	"github.com/senzing-garage/serve-grpc/szexportserver"
 	"github.com/senzing-garage/serve-grpc/szproductserver"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
 	"github.com/senzing-garage/sz-sdk-proto/go/szconfig"
@@ -27,9 +36,11 @@ import (
 	"github.com/senzing-garage/sz-sdk-proto/go/szengine"
 	"github.com/senzing-garage/sz-sdk-proto/go/szproduct"
 	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // ----------------------------------------------------------------------------
@@ -38,26 +49,70 @@ import (
 
 // BasicGrpcServer is the default implementation of the GrpcServer interface.
 type BasicGrpcServer struct {
+	AccessPolicy          *AccessPolicy
+	Authenticators        []Authenticator
 	AvoidServing          bool
+	backgroundMutex       sync.Mutex // Orders the background work Serve starts before Shutdown stops it.
+	BatchMaxItems         int
+	BatchWorkers          int
 	BindAddress           string
+	BulkProgressInterval  int
+	BulkWorkers           int
 	EnableAll             bool
+	EnableNotifications   bool
 	EnableSzConfig        bool
 	EnableSzConfigManager bool
 	EnableSzDiagnostic    bool
 	EnableSzEngine        bool
 	EnableSzProduct       bool
+	ExportDirectory       string
+	ExportIdleTTL         time.Duration
+	ExportResumption      ExportResumption
 	grpcserver            *grpc.Server
 	GrpcServerOptions     []grpc.ServerOption
+	HealthCheckInterval   time.Duration
+	healthChecksDone      chan struct{}
+	healthChecksStopped   chan struct{}
+	healthServer          *health.Server
+	healthStatuses        map[string]healthpb.HealthCheckResponse_ServingStatus
+	internalObservers     []observer.Observer
 	isInitialized         bool
+	isShuttingDown        bool
+	jobManager            *jobManager
+	Jobs                  Jobs
 	logger                logging.Logging
 	LogLevelName          string
+	metrics               *grpcMetrics
+	notificationFeed      *notificationFeed
+	MethodTimeouts        []MethodTimeout
 	ObserverOrigin        string
 	Observers             []observer.Observer
 	ObserverURL           string
 	Port                  int
+	RateLimits            *RateLimits
+	rateLimiter           *rateLimiter
+	ReadOnly              bool
+	RedoProcessing        *RedoProcessing
+	redoProcessor         *redoProcessor
 	SenzingInstanceName   string
 	SenzingSettings       string
 	SenzingVerboseLogging int64
+	SinglePort            bool // Calls arrive through BasicHTTPServer on its port, so Serve does not listen on Port.
+	stopExportReaper      context.CancelFunc
+	stopHealthChecks      func()
+	stopServing           chan struct{}
+	TracerProvider        trace.TracerProvider
+}
+
+/*
+ExportResumption configures the resume tokens of the szexport.SzExport service.
+Zero values use the szexportserver defaults.  Servers sharing a TokenKey accept each
+other's tokens; without one, tokens only work on the server that issued them.
+*/
+type ExportResumption struct {
+	CheckpointInterval int64
+	TokenKey           []byte
+	TokenTTL           time.Duration
 }
 
 const OptionCallerSkip = 3
@@ -86,6 +141,12 @@ func (grpcServer *BasicGrpcServer) Initialize(ctx context.Context) error {
 		}
 	}
 
+	// Publish notifications to szadmin.SzAdmin/WatchNotifications.
+
+	if grpcServer.EnableNotifications {
+		grpcServer.enableNotifications()
+	}
+
 	// Special database processing.
 
 	err = initializeDatabase(ctx, grpcServer.SenzingSettings)
@@ -95,16 +156,55 @@ func (grpcServer *BasicGrpcServer) Initialize(ctx context.Context) error {
 
 	// Create server.
 
-	grpcServer.grpcserver = grpc.NewServer(grpcServer.GrpcServerOptions...)
+	grpcServer.metrics = newGrpcMetrics()
+
+	if grpcServer.RateLimits != nil {
+		grpcServer.rateLimiter = newRateLimiter(grpcServer.RateLimits)
+	}
+
+	grpcServer.grpcserver = grpc.NewServer(grpcServer.getServerOptions()...)
 
 	// Register services with gRPC server.
 
 	grpcServer.enableServices(ctx, grpcServer.grpcserver)
+	grpcServer.enableSzAdmin()
+
+	err = grpcServer.enableSzJob()
+	if err != nil {
+		return err
+	}
+
+	// Prepare background redo processing.
+
+	if grpcServer.RedoProcessing != nil && grpcServer.RedoProcessing.Workers > 0 {
+		switch {
+		case grpcServer.ReadOnly:
+			return wraperror.Errorf(errForPackage, "redo processing changes the repository and cannot run on a read-only server")
+		case !grpcServer.EnableAll && !grpcServer.EnableSzEngine:
+			return wraperror.Errorf(errForPackage, "redo processing needs the SzEngine service to be enabled")
+		}
+
+		grpcServer.redoProcessor = grpcServer.newRedoProcessor(ctx)
+	}
+
+	// Enable grpc.health.v1.Health.
+
+	grpcServer.enableHealth(ctx)
+
+	if grpcServer.ReadOnly {
+		grpcServer.log(2008)
+		grpcServer.enableReadOnlyHealth()
+	}
 
 	// Enable reflection.
 
-	reflection.Register(grpcServer.grpcserver)
+	err = grpcServer.enableReflection()
+	if err != nil {
+		return err
+	}
 
+	grpcServer.stopServing = make(chan struct{})
+	grpcServer.isShuttingDown = false
 	grpcServer.isInitialized = true
 
 	return wraperror.Errorf(err, wraperror.NoMessage)
@@ -124,6 +224,10 @@ func (grpcServer *BasicGrpcServer) Serve(ctx context.Context) error {
 		)
 	}
 
+	if grpcServer.SinglePort {
+		return grpcServer.serveSinglePort(ctx)
+	}
+
 	listenConfig := &net.ListenConfig{ //nolint
 		KeepAlive: keepAliveSeconds * time.Second,
 	}
@@ -133,11 +237,13 @@ func (grpcServer *BasicGrpcServer) Serve(ctx context.Context) error {
 	listener, err := listenConfig.Listen(ctx, "tcp", fmt.Sprintf("%s:%d", grpcServer.BindAddress, grpcServer.Port))
 	if err != nil {
 		grpcServer.log(4001, grpcServer.Port, err)
+
+		return wraperror.Errorf(err, "Listen")
 	}
 
 	defer func() {
 		err := listener.Close()
-		if err != nil {
+		if err != nil && !errors.Is(err, net.ErrClosed) {
 			panic(err)
 		}
 	}()
@@ -146,7 +252,12 @@ func (grpcServer *BasicGrpcServer) Serve(ctx context.Context) error {
 
 	if !grpcServer.AvoidServing {
 		grpcServer.log(2003, listener.Addr())
+		grpcServer.startBackgroundWork(ctx)
+
 		err = grpcServer.grpcserver.Serve(listener)
+		if errors.Is(err, grpc.ErrServerStopped) {
+			err = nil
+		}
 	} else {
 		grpcServer.log(2004)
 	}
@@ -154,10 +265,127 @@ func (grpcServer *BasicGrpcServer) Serve(ctx context.Context) error {
 	return wraperror.Errorf(err, wraperror.NoMessage)
 }
 
+/*
+The Shutdown method stops the gRPC server and releases the Senzing SDK.
+New calls are refused immediately; in-flight calls and export streams are allowed to
+finish until ctx is done, at which point remaining calls are cancelled.
+Once all calls have returned, the Senzing SDK singletons are destroyed.
+
+Input
+  - ctx: A context to control lifecycle. Its deadline bounds the graceful drain.
+
+Output
+  - Nothing is returned, except for an error.
+*/
+func (grpcServer *BasicGrpcServer) Shutdown(ctx context.Context) error {
+	var err error
+
+	if !grpcServer.isInitialized {
+		return wraperror.Errorf(
+			errForPackage,
+			"grpcserver.Shutdown is not initialized. BasicGrpcServer.Initialize() must be called first.",
+		)
+	}
+
+	grpcServer.log(2005)
+
+	// Start no more background work.  What Serve has started is visible from here on.
+
+	grpcServer.backgroundMutex.Lock()
+	grpcServer.isShuttingDown = true
+	grpcServer.backgroundMutex.Unlock()
+
+	// Report NOT_SERVING to health checkers while draining.
+
+	grpcServer.stopHealthChecks()
+	grpcServer.healthServer.Shutdown()
+
+	// Drain in-flight calls until ctx is done.
+
+	stopped := make(chan struct{})
+
+	go func() {
+		grpcServer.grpcserver.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		grpcServer.log(3001, context.Cause(ctx))
+		grpcServer.grpcserver.Stop()
+		<-stopped
+	}
+
+	close(grpcServer.stopServing)
+
+	// Let redo workers finish their current redo record.
+
+	grpcServer.stopRedoProcessor(ctx)
+
+	// Cancel jobs.  Canceled file exports leave their files incomplete.
+
+	if grpcServer.jobManager != nil {
+		grpcServer.jobManager.stop(ctx)
+	}
+
+	// Close exports that clients left open.
+
+	if grpcServer.stopExportReaper != nil {
+		grpcServer.stopExportReaper()
+	}
+
+	err = szengineserver.CloseAllExports(ctx)
+	if err != nil {
+		grpcServer.log(3006, err)
+	}
+
+	// Release Senzing SDK, once no health check is probing it.
+
+	grpcServer.waitHealthChecks(ctx)
+
+	err = grpcServer.destroyServices(ctx)
+	grpcServer.isInitialized = false
+
+	grpcServer.log(2006)
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
 // ----------------------------------------------------------------------------
 // Private methods
 // ----------------------------------------------------------------------------
 
+// Start the health checks, redo processor and export reaper, unless Shutdown has begun.
+func (grpcServer *BasicGrpcServer) startBackgroundWork(ctx context.Context) {
+	grpcServer.backgroundMutex.Lock()
+	defer grpcServer.backgroundMutex.Unlock()
+
+	if grpcServer.isShuttingDown {
+		return
+	}
+
+	grpcServer.startHealthChecks(ctx)
+	grpcServer.startRedoProcessor(ctx)
+	grpcServer.startExportReaper(ctx)
+}
+
+// Run the background work of a server whose calls arrive through BasicHTTPServer, until Shutdown.
+func (grpcServer *BasicGrpcServer) serveSinglePort(ctx context.Context) error {
+	if grpcServer.AvoidServing {
+		grpcServer.log(2004)
+
+		return nil
+	}
+
+	grpcServer.log(2016)
+	grpcServer.startBackgroundWork(ctx)
+
+	<-grpcServer.stopServing
+
+	return nil
+}
+
 // --- Logging -------------------------------------------------------------------------
 
 // Get the Logger singleton.
@@ -236,6 +464,9 @@ func (grpcServer *BasicGrpcServer) enableServices(ctx context.Context, aGrpcServ
 
 	if grpcServer.EnableAll || grpcServer.EnableSzEngine {
 		grpcServer.enableSzEngine(ctx, aGrpcServer)
+		grpcServer.enableSzBatch(ctx, aGrpcServer)
+		grpcServer.enableSzBulk(ctx, aGrpcServer)
+		grpcServer.enableSzExport(ctx, aGrpcServer)
 	}
 
 	if grpcServer.EnableAll || grpcServer.EnableSzProduct {
@@ -243,6 +474,38 @@ func (grpcServer *BasicGrpcServer) enableServices(ctx context.Context, aGrpcServ
 	}
 }
 
+// Add SzBatch service to gRPC server.  It shares the SzEngine that enableSzEngine initializes.
+func (grpcServer *BasicGrpcServer) enableSzBatch(ctx context.Context, serviceRegistrar grpc.ServiceRegistrar) {
+	server := &szbatchserver.SzBatchServer{
+		MaxItems:        grpcServer.BatchMaxItems,
+		StatusFromError: StatusFromError,
+		Workers:         grpcServer.BatchWorkers,
+	}
+
+	err := server.SetLogLevel(ctx, grpcServer.LogLevelName)
+	if err != nil {
+		panic(err)
+	}
+
+	szbatch.RegisterSzBatchServer(serviceRegistrar, server)
+}
+
+// Add SzBulk service to gRPC server.  It shares the SzEngine that enableSzEngine initializes.
+func (grpcServer *BasicGrpcServer) enableSzBulk(ctx context.Context, serviceRegistrar grpc.ServiceRegistrar) {
+	server := &szbulkserver.SzBulkServer{
+		ProgressInterval: grpcServer.BulkProgressInterval,
+		StatusFromError:  StatusFromError,
+		Workers:          grpcServer.BulkWorkers,
+	}
+
+	err := server.SetLogLevel(ctx, grpcServer.LogLevelName)
+	if err != nil {
+		panic(err)
+	}
+
+	szbulk.RegisterSzBulkServer(serviceRegistrar, server)
+}
+
 // Add SzConfig service to gRPC server.
 func (grpcServer *BasicGrpcServer) enableSzConfig(ctx context.Context, serviceRegistrar grpc.ServiceRegistrar) {
 	server := &szconfigserver.SzConfigServer{}
@@ -258,12 +521,10 @@ func (grpcServer *BasicGrpcServer) enableSzConfig(ctx context.Context, serviceRe
 		panic(err)
 	}
 
-	if grpcServer.Observers != nil {
-		for _, observer := range grpcServer.Observers {
-			err = server.RegisterObserver(ctx, observer)
-			if err != nil {
-				panic(err)
-			}
+	for _, observer := range grpcServer.getObservers() {
+		err = server.RegisterObserver(ctx, observer)
+		if err != nil {
+			panic(err)
 		}
 	}
 
@@ -289,12 +550,10 @@ func (grpcServer *BasicGrpcServer) enableSzConfigManager(ctx context.Context, se
 		panic(err)
 	}
 
-	if grpcServer.Observers != nil {
-		for _, observer := range grpcServer.Observers {
-			err = server.RegisterObserver(ctx, observer)
-			if err != nil {
-				panic(err)
-			}
+	for _, observer := range grpcServer.getObservers() {
+		err = server.RegisterObserver(ctx, observer)
+		if err != nil {
+			panic(err)
 		}
 	}
 
@@ -320,12 +579,10 @@ func (grpcServer *BasicGrpcServer) enableSzDiagnostic(ctx context.Context, servi
 		panic(err)
 	}
 
-	if grpcServer.Observers != nil {
-		for _, observer := range grpcServer.Observers {
-			err = server.RegisterObserver(ctx, observer)
-			if err != nil {
-				panic(err)
-			}
+	for _, observer := range grpcServer.getObservers() {
+		err = server.RegisterObserver(ctx, observer)
+		if err != nil {
+			panic(err)
 		}
 	}
 
@@ -338,7 +595,7 @@ func (grpcServer *BasicGrpcServer) enableSzDiagnostic(ctx context.Context, servi
 
 // Add SzEngine service to gRPC server.
 func (grpcServer *BasicGrpcServer) enableSzEngine(ctx context.Context, serviceRegistrar grpc.ServiceRegistrar) {
-	server := &szengineserver.SzEngineServer{}
+	server := &szengineserver.SzEngineServer{ExportOwner: exportOwner}
 
 	err := server.SetLogLevel(ctx, grpcServer.LogLevelName)
 	if err != nil {
@@ -351,12 +608,10 @@ func (grpcServer *BasicGrpcServer) enableSzEngine(ctx context.Context, serviceRe
 		panic(err)
 	}
 
-	if grpcServer.Observers != nil {
-		for _, observer := range grpcServer.Observers {
-			err = server.RegisterObserver(ctx, observer)
-			if err != nil {
-				panic(err)
-			}
+	for _, observer := range grpcServer.getObservers() {
+		err = server.RegisterObserver(ctx, observer)
+		if err != nil {
+			panic(err)
 		}
 	}
 
@@ -367,6 +622,22 @@ func (grpcServer *BasicGrpcServer) enableSzEngine(ctx context.Context, serviceRe
 	szengine.RegisterSzEngineServer(serviceRegistrar, server)
 }
 
+// Add SzExport service to gRPC server.  It shares the SzEngine that enableSzEngine initializes.
+func (grpcServer *BasicGrpcServer) enableSzExport(ctx context.Context, serviceRegistrar grpc.ServiceRegistrar) {
+	server := &szexportserver.SzExportServer{
+		CheckpointInterval: grpcServer.ExportResumption.CheckpointInterval,
+		ResumeTokenKey:     grpcServer.ExportResumption.TokenKey,
+		ResumeTokenTTL:     grpcServer.ExportResumption.TokenTTL,
+	}
+
+	err := server.SetLogLevel(ctx, grpcServer.LogLevelName)
+	if err != nil {
+		panic(err)
+	}
+
+	szexport.RegisterSzExportServer(serviceRegistrar, server)
+}
+
 // Add SzProduct service to gRPC server.
 func (grpcServer *BasicGrpcServer) enableSzProduct(ctx context.Context, serviceRegistrar grpc.ServiceRegistrar) {
 	server := &szproductserver.SzProductServer{}
@@ -382,12 +653,10 @@ func (grpcServer *BasicGrpcServer) enableSzProduct(ctx context.Context, serviceR
 		panic(err)
 	}
 
-	if grpcServer.Observers != nil {
-		for _, observer := range grpcServer.Observers {
-			err = server.RegisterObserver(ctx, observer)
-			if err != nil {
-				panic(err)
-			}
+	for _, observer := range grpcServer.getObservers() {
+		err = server.RegisterObserver(ctx, observer)
+		if err != nil {
+			panic(err)
 		}
 	}
 
@@ -398,6 +667,89 @@ func (grpcServer *BasicGrpcServer) enableSzProduct(ctx context.Context, serviceR
 	szproduct.RegisterSzProductServer(serviceRegistrar, server)
 }
 
+// --- Server options ------------------------------------------------------------------
+
+// Options built in to every BasicGrpcServer, followed by GrpcServerOptions.
+// WaitForHandlers lets Stop() wait for in-flight handlers before the SDK is destroyed.
+// The caller's Observers plus those the server adds itself, such as the notification feed.
+func (grpcServer *BasicGrpcServer) getObservers() []observer.Observer {
+	return slices.Concat(grpcServer.Observers, grpcServer.internalObservers)
+}
+
+func (grpcServer *BasicGrpcServer) getServerOptions() []grpc.ServerOption {
+	result := []grpc.ServerOption{
+		grpc.WaitForHandlers(true),
+		grpc.StatsHandler(grpcServer.getTracingStatsHandler()),
+		grpc.ChainUnaryInterceptor(grpcServer.getUnaryInterceptors()...),
+		grpc.ChainStreamInterceptor(grpcServer.getStreamInterceptors()...),
+	}
+
+	return append(result, grpcServer.GrpcServerOptions...)
+}
+
+// Unary interceptors, outermost first.
+func (grpcServer *BasicGrpcServer) getUnaryInterceptors() []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{
+		grpcServer.metricsUnaryInterceptor,
+		grpcServer.authUnaryInterceptor,
+		grpcServer.authzUnaryInterceptor,
+		grpcServer.readOnlyUnaryInterceptor,
+		grpcServer.rateLimitUnaryInterceptor,
+		grpcServer.timeoutUnaryInterceptor,
+		grpcServer.errorsUnaryInterceptor,
+	}
+}
+
+// Stream interceptors, outermost first.
+func (grpcServer *BasicGrpcServer) getStreamInterceptors() []grpc.StreamServerInterceptor {
+	return []grpc.StreamServerInterceptor{
+		grpcServer.metricsStreamInterceptor,
+		grpcServer.authStreamInterceptor,
+		grpcServer.authzStreamInterceptor,
+		grpcServer.readOnlyStreamInterceptor,
+		grpcServer.rateLimitStreamInterceptor,
+		grpcServer.timeoutStreamInterceptor,
+		grpcServer.errorsStreamInterceptor,
+	}
+}
+
+// --- Destroying services -------------------------------------------------------------
+
+// Destroy the Senzing SDK singletons in the reverse order of enableServices.
+// Every enabled singleton is destroyed, even if an earlier one fails.
+func (grpcServer *BasicGrpcServer) destroyServices(ctx context.Context) error {
+	var result error
+
+	destroyers := []struct {
+		enabled       bool
+		messageNumber int
+		destroy       func(context.Context) error
+	}{
+		{grpcServer.EnableSzProduct, 4007, szproductserver.GetSdkSzProduct().Destroy},
+		{grpcServer.EnableSzEngine, 4003, szengineserver.GetSdkSzEngine().Destroy},
+		{grpcServer.EnableSzDiagnostic, 4004, szdiagnosticserver.GetSdkSzDiagnostic().Destroy},
+		{grpcServer.EnableSzConfigManager, 4005, szconfigmanagerserver.GetSdkSzConfigManager().Destroy},
+		{grpcServer.EnableSzConfig, 4006, szconfigserver.GetSdkSzConfigManager().Destroy},
+	}
+
+	for _, destroyer := range destroyers {
+		if !grpcServer.EnableAll && !destroyer.enabled {
+			continue
+		}
+
+		err := destroyer.destroy(ctx)
+		if err != nil {
+			grpcServer.log(destroyer.messageNumber, err)
+
+			if result == nil {
+				result = err
+			}
+		}
+	}
+
+	return wraperror.Errorf(result, wraperror.NoMessage)
+}
+
 func (grpcServer *BasicGrpcServer) setupObserver(ctx context.Context) error {
 	var (
 		anObserver observer.Observer
@@ -0,0 +1,194 @@
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// errorMapping maps a Senzing error class to a gRPC status code and google.rpc.ErrorInfo reason.
+type errorMapping struct {
+	code   codes.Code
+	err    error
+	reason string
+}
+
+// senzingErrorMessage holds the fields of the JSON message produced by the Senzing SDK for an exception.
+type senzingErrorMessage struct {
+	Code   string `json:"code"`
+	ID     string `json:"id"`
+	Reason string `json:"reason"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// ErrorInfoDomain is the google.rpc.ErrorInfo domain attached to Senzing errors.
+const ErrorInfoDomain = "senzing.com"
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Senzing error classes, most specific first.
+// A Senzing error usually belongs to several classes (e.g. not-found is also bad-input),
+// so the first match wins.
+var errorMappings = []errorMapping{
+	{codes.Canceled, context.Canceled, "CANCELED"},
+	{codes.DeadlineExceeded, context.DeadlineExceeded, "DEADLINE_EXCEEDED"},
+	{codes.NotFound, szerror.ErrSzNotFound, "SZ_NOT_FOUND"},
+	{codes.InvalidArgument, szerror.ErrSzUnknownDataSource, "SZ_UNKNOWN_DATA_SOURCE"},
+	{codes.Aborted, szerror.ErrSzReplaceConflict, "SZ_REPLACE_CONFLICT"},
+	{codes.Unavailable, szerror.ErrSzRetryTimeoutExceeded, "SZ_RETRY_TIMEOUT_EXCEEDED"},
+	{codes.Unavailable, szerror.ErrSzDatabaseConnectionLost, "SZ_DATABASE_CONNECTION_LOST"},
+	{codes.Unavailable, szerror.ErrSzDatabaseTransient, "SZ_DATABASE_TRANSIENT"},
+	{codes.Unavailable, szerror.ErrSzRetryable, "SZ_RETRYABLE"},
+	{codes.InvalidArgument, szerror.ErrSzBadInput, "SZ_BAD_INPUT"},
+	{codes.FailedPrecondition, szerror.ErrSzLicense, "SZ_LICENSE"},
+	{codes.FailedPrecondition, szerror.ErrSzNotInitialized, "SZ_NOT_INITIALIZED"},
+	{codes.FailedPrecondition, szerror.ErrSzConfiguration, "SZ_CONFIGURATION"},
+	{codes.Internal, szerror.ErrSzDatabase, "SZ_DATABASE"},
+	{codes.Internal, szerror.ErrSzUnrecoverable, "SZ_UNRECOVERABLE"},
+	{codes.Unknown, szerror.ErrSzUnhandled, "SZ_UNHANDLED"},
+	{codes.Unknown, szerror.ErrSzSdk, "SZ_SDK"},
+	{codes.Unknown, szerror.ErrSzGeneral, "SZ_GENERAL"},
+	{codes.Unknown, szerror.ErrSz, "SZ_ERROR"},
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The StatusFromError function translates an error returned by a Senzing SDK call
+into a gRPC status error.
+
+The status code is chosen from the Senzing error class (not-found, bad-input,
+retryable, ...).  The message is err.Error(), unchanged, so clients that parse
+the message keep working.  A google.rpc.ErrorInfo detail carries the error class
+as its reason and the Senzing message id, exception code and reason as metadata.
+
+Input
+  - err: An error returned by a Senzing SDK call, or nil.
+
+Output
+  - nil if err is nil; err itself if it already carries a gRPC status; otherwise a gRPC status error.
+*/
+func StatusFromError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, isStatus := status.FromError(err); isStatus {
+		return err
+	}
+
+	code := codes.Unknown
+	errorInfo := &errdetails.ErrorInfo{
+		Domain:   ErrorInfoDomain,
+		Reason:   "UNKNOWN",
+		Metadata: map[string]string{},
+	}
+
+	for _, mapping := range errorMappings {
+		if errors.Is(err, mapping.err) {
+			code = mapping.code
+			errorInfo.Reason = mapping.reason
+
+			break
+		}
+	}
+
+	if message, found := findSenzingErrorMessage(err); found {
+		setIfNotEmpty(errorInfo.GetMetadata(), "id", message.ID)
+		setIfNotEmpty(errorInfo.GetMetadata(), "code", message.Code)
+		setIfNotEmpty(errorInfo.GetMetadata(), "reason", message.Reason)
+	}
+
+	result := status.New(code, err.Error())
+
+	resultWithDetails, detailsErr := result.WithDetails(errorInfo)
+	if detailsErr != nil {
+		return result.Err()
+	}
+
+	return resultWithDetails.Err()
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Translate Senzing errors returned by unary handlers into gRPC status errors.
+func (grpcServer *BasicGrpcServer) errorsUnaryInterceptor(
+	ctx context.Context,
+	request any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	_ = info
+	response, err := handler(ctx, request)
+
+	return response, StatusFromError(err)
+}
+
+// Translate Senzing errors returned by stream handlers into gRPC status errors.
+func (grpcServer *BasicGrpcServer) errorsStreamInterceptor(
+	server any,
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	_ = info
+
+	return StatusFromError(handler(server, stream))
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Walk the error tree looking for the JSON message created by the Senzing SDK.
+func findSenzingErrorMessage(err error) (senzingErrorMessage, bool) {
+	var result senzingErrorMessage
+
+	if err == nil {
+		return result, false
+	}
+
+	switch unwrappable := err.(type) {
+	case interface{ Unwrap() []error }:
+		for _, wrappedErr := range unwrappable.Unwrap() {
+			if result, found := findSenzingErrorMessage(wrappedErr); found {
+				return result, true
+			}
+		}
+	case interface{ Unwrap() error }:
+		if result, found := findSenzingErrorMessage(unwrappable.Unwrap()); found {
+			return result, true
+		}
+	}
+
+	if json.Unmarshal([]byte(err.Error()), &result) == nil && len(result.ID) > 0 {
+		return result, true
+	}
+
+	return result, false
+}
+
+func setIfNotEmpty(metadata map[string]string, key string, value string) {
+	if len(value) > 0 {
+		metadata[key] = value
+	}
+}
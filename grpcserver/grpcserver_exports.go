@@ -0,0 +1,91 @@
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	szadminpb "github.com/senzing-garage/serve-grpc/proto/go/szadmin"
+	"github.com/senzing-garage/serve-grpc/szengineserver"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Default time an export opened with SzEngine.ExportCsvEntityReport or ExportJsonEntityReport may go unused.
+const DefaultExportIdleTTL = 10 * time.Minute
+
+// Shortest period between looks for idle exports, however short ExportIdleTTL is.
+const minExportReapPeriod = 10 * time.Millisecond
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Close idle exports every half ExportIdleTTL, or minExportReapPeriod, until stopExportReaper is called.
+func (grpcServer *BasicGrpcServer) startExportReaper(ctx context.Context) {
+	reaperCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	grpcServer.stopExportReaper = cancel
+	idleTTL := grpcServer.getExportIdleTTL()
+
+	go func() {
+		ticker := time.NewTicker(max(idleTTL/2, minExportReapPeriod)) //nolint:mnd // Reap within 1.5 * idleTTL.
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-reaperCtx.Done():
+				return
+			case <-ticker.C:
+				grpcServer.closeIdleExports(reaperCtx, idleTTL)
+			}
+		}
+	}()
+}
+
+func (grpcServer *BasicGrpcServer) closeIdleExports(ctx context.Context, idleTTL time.Duration) {
+	closed, err := szengineserver.CloseIdleExports(ctx, idleTTL)
+	for _, exportInfo := range closed {
+		grpcServer.log(2013, exportInfo.ID, exportInfo.Owner, time.Since(exportInfo.LastAccess).Round(time.Second))
+	}
+
+	if err != nil {
+		grpcServer.log(3006, err)
+	}
+}
+
+func (grpcServer *BasicGrpcServer) getExportIdleTTL() time.Duration {
+	if grpcServer.ExportIdleTTL > 0 {
+		return grpcServer.ExportIdleTTL
+	}
+
+	return DefaultExportIdleTTL
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Exports belong to the authenticated principal.  Without one, only the export id is needed.
+func exportOwner(ctx context.Context) string {
+	principal, isAuthenticated := PrincipalFromContext(ctx)
+	if !isAuthenticated {
+		return ""
+	}
+
+	return principal.AuthenticationMethod + ":" + principal.Name
+}
+
+func exportInfoToProto(exportInfo szengineserver.ExportInfo) *szadminpb.ExportInfo {
+	return &szadminpb.ExportInfo{
+		ExportId:      exportInfo.ID,
+		Owner:         exportInfo.Owner,
+		Format:        exportInfo.Format,
+		Flags:         exportInfo.Flags,
+		CsvColumnList: exportInfo.CsvColumnList,
+		Created:       timestamppb.New(exportInfo.CreatedAt),
+		LastAccess:    timestamppb.New(exportInfo.LastAccess),
+		RowsFetched:   exportInfo.RowsFetched,
+	}
+}
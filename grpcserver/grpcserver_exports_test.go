@@ -0,0 +1,112 @@
+package grpcserver_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/settings"
+	"github.com/senzing-garage/serve-grpc/grpcserver"
+	szadminpb "github.com/senzing-garage/serve-grpc/proto/go/szadmin"
+	szenginepb "github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestGrpcServerImpl_ExportRegistry(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableSzEngine:      true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	clientConn := serveBufconn(test, grpcServer)
+	adminClient := szadminpb.NewSzAdminClient(clientConn)
+	engineClient := szenginepb.NewSzEngineClient(clientConn)
+
+	exportResponse, err := engineClient.ExportJsonEntityReport(ctx, &szenginepb.ExportJsonEntityReportRequest{})
+	require.NoError(test, err)
+
+	exportID := exportResponse.GetResult()
+	require.Positive(test, exportID)
+
+	exportInfo, err := adminClient.GetExport(ctx, &szadminpb.GetExportRequest{ExportId: exportID})
+	require.NoError(test, err)
+	require.Equal(test, "json", exportInfo.GetFormat())
+
+	exportList, err := adminClient.ListExports(ctx, &szadminpb.ListExportsRequest{})
+	require.NoError(test, err)
+	require.Len(test, exportList.GetExports(), 1)
+
+	// Unknown export ids are not found.
+
+	_, err = engineClient.FetchNext(ctx, &szenginepb.FetchNextRequest{ExportHandle: exportID + 1})
+	require.Equal(test, codes.NotFound, status.Code(err))
+
+	_, err = engineClient.CloseExportReport(ctx, &szenginepb.CloseExportReportRequest{ExportHandle: exportID})
+	require.NoError(test, err)
+
+	_, err = adminClient.GetExport(ctx, &szadminpb.GetExportRequest{ExportId: exportID})
+	require.Equal(test, codes.NotFound, status.Code(err))
+}
+
+func TestGrpcServerImpl_ExportRegistry_idle(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		EnableSzEngine:      true,
+		ExportIdleTTL:       time.Nanosecond,
+		LogLevelName:        "INFO",
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+		SinglePort:          true,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	clientConn := serveBufconn(test, grpcServer)
+	adminClient := szadminpb.NewSzAdminClient(clientConn)
+	engineClient := szenginepb.NewSzEngineClient(clientConn)
+
+	served := make(chan error, 1)
+
+	go func() { served <- grpcServer.Serve(ctx) }()
+
+	exportResponse, err := engineClient.ExportJsonEntityReport(ctx, &szenginepb.ExportJsonEntityReportRequest{})
+	require.NoError(test, err)
+
+	// The export is closed once it has gone unused for longer than ExportIdleTTL.
+
+	require.Eventually(test, func() bool {
+		_, err := adminClient.GetExport(ctx, &szadminpb.GetExportRequest{ExportId: exportResponse.GetResult()})
+
+		return status.Code(err) == codes.NotFound
+	}, 10*time.Second, time.Millisecond)
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	err = grpcServer.Shutdown(shutdownCtx)
+	require.NoError(test, err)
+	require.NoError(test, <-served)
+}
@@ -0,0 +1,215 @@
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/serve-grpc/fileexport"
+	szadminpb "github.com/senzing-garage/serve-grpc/proto/go/szadmin"
+	szjobpb "github.com/senzing-garage/serve-grpc/proto/go/szjob"
+	"github.com/senzing-garage/serve-grpc/szengineserver"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Progress counters of exportFile jobs.
+const (
+	fileExportProgressBytes = "bytes_written"
+	fileExportProgressRows  = "rows_written"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var fileExportCompressions = map[szadminpb.FileExportCompression]fileexport.Compression{
+	szadminpb.FileExportCompression_FILE_EXPORT_COMPRESSION_NONE: fileexport.CompressionNone,
+	szadminpb.FileExportCompression_FILE_EXPORT_COMPRESSION_GZIP: fileexport.CompressionGzip,
+	szadminpb.FileExportCompression_FILE_EXPORT_COMPRESSION_ZSTD: fileexport.CompressionZstd,
+}
+
+// Pending jobs are reported as running, because file exports had no pending state.
+var fileExportStates = map[szjobpb.JobState]szadminpb.FileExportState{
+	szjobpb.JobState_JOB_STATE_PENDING:   szadminpb.FileExportState_FILE_EXPORT_STATE_RUNNING,
+	szjobpb.JobState_JOB_STATE_RUNNING:   szadminpb.FileExportState_FILE_EXPORT_STATE_RUNNING,
+	szjobpb.JobState_JOB_STATE_SUCCEEDED: szadminpb.FileExportState_FILE_EXPORT_STATE_SUCCEEDED,
+	szjobpb.JobState_JOB_STATE_FAILED:    szadminpb.FileExportState_FILE_EXPORT_STATE_FAILED,
+	szjobpb.JobState_JOB_STATE_CANCELED:  szadminpb.FileExportState_FILE_EXPORT_STATE_CANCELED,
+}
+
+var fileExportFormats = map[szadminpb.FileExportFormat]fileexport.Format{
+	szadminpb.FileExportFormat_FILE_EXPORT_FORMAT_CSV:     fileexport.FormatCSV,
+	szadminpb.FileExportFormat_FILE_EXPORT_FORMAT_NDJSON:  fileexport.FormatNDJSON,
+	szadminpb.FileExportFormat_FILE_EXPORT_FORMAT_PARQUET: fileexport.FormatParquet,
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+/*
+Check request and get a jobRunner that writes the export to files.  The files are
+created when the job runs, so a name already in use makes the job fail.
+*/
+func (grpcServer *BasicGrpcServer) newFileExportRunner(
+	jobID string,
+	request *szadminpb.StartFileExportRequest,
+) (jobRunner, error) {
+	if len(grpcServer.ExportDirectory) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "file exports are not enabled on this server")
+	}
+
+	if !grpcServer.EnableAll && !grpcServer.EnableSzEngine {
+		return nil, status.Error(codes.FailedPrecondition, "file exports need the SzEngine service to be enabled")
+	}
+
+	format, isKnown := fileExportFormats[request.GetFormat()]
+	if !isKnown {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported format %s", request.GetFormat())
+	}
+
+	compression, isKnown := fileExportCompressions[request.GetCompression()]
+	if !isKnown {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported compression %s", request.GetCompression())
+	}
+
+	name := request.GetName()
+	if len(name) == 0 {
+		name = "export-" + jobID
+	}
+
+	writer, err := fileexport.NewWriter(fileexport.Options{
+		Compression:  compression,
+		Directory:    grpcServer.ExportDirectory,
+		Format:       format,
+		MaxFileBytes: request.GetMaxFileBytes(),
+		Name:         name,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	runner := func(ctx context.Context, job *job) (string, error) {
+		job.update(func(record *jobRecord) {
+			job.progress = func(record *jobRecord) {
+				record.Progress = map[string]int64{
+					fileExportProgressBytes: writer.BytesWritten(),
+					fileExportProgressRows:  writer.RowsWritten(),
+				}
+			}
+			job.progress(record)
+		})
+
+		err := exportToFiles(ctx, request, writer)
+
+		result, marshalErr := json.Marshal(map[string][]string{"files": writer.Files()})
+		if marshalErr != nil {
+			return "", wraperror.Errorf(marshalErr, "Marshal")
+		}
+
+		return string(result), err
+	}
+
+	return runner, nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Run the Senzing export and write its rows.
+func exportToFiles(ctx context.Context, request *szadminpb.StartFileExportRequest, writer *fileexport.Writer) (err error) {
+	szEngine := szengineserver.GetSdkSzEngine()
+
+	var exportHandle uintptr
+
+	if request.GetFormat() == szadminpb.FileExportFormat_FILE_EXPORT_FORMAT_NDJSON {
+		exportHandle, err = szEngine.ExportJSONEntityReport(ctx, request.GetFlags())
+		if err != nil {
+			return wraperror.Errorf(err, "ExportJSONEntityReport")
+		}
+	} else {
+		exportHandle, err = szEngine.ExportCsvEntityReport(ctx, request.GetCsvColumnList(), request.GetFlags())
+		if err != nil {
+			return wraperror.Errorf(err, "ExportCsvEntityReport")
+		}
+	}
+
+	defer func() {
+		closeErr := szEngine.CloseExportReport(context.WithoutCancel(ctx), exportHandle)
+		err = errors.Join(err, wraperror.Errorf(closeErr, "CloseExportReport"))
+	}()
+
+	for {
+		err = ctx.Err()
+		if err != nil {
+			_ = writer.Close()
+
+			return wraperror.Errorf(err, wraperror.NoMessage)
+		}
+
+		var row string
+
+		row, err = szEngine.FetchNext(ctx, exportHandle)
+		if err != nil {
+			_ = writer.Close()
+
+			return wraperror.Errorf(err, "FetchNext")
+		}
+
+		if len(row) == 0 {
+			return wraperror.Errorf(writer.Close(), "Close")
+		}
+
+		err = writer.Write(row)
+		if err != nil {
+			_ = writer.Close()
+
+			return wraperror.Errorf(err, "Write")
+		}
+	}
+}
+
+// Describe an exportFile job as the SzAdmin file export status.
+func jobToFileExportStatus(job *szjobpb.Job) (*szadminpb.FileExportStatus, error) {
+	if job.GetKind() != jobKindExportFile {
+		return nil, status.Errorf(codes.NotFound, "file export %q does not exist", job.GetJobId())
+	}
+
+	result := &szadminpb.FileExportStatus{
+		JobId:        job.GetJobId(),
+		State:        fileExportStates[job.GetState()],
+		RowsWritten:  job.GetProgress()[fileExportProgressRows],
+		BytesWritten: job.GetProgress()[fileExportProgressBytes],
+		Started:      job.GetStarted(),
+		Finished:     job.GetFinished(),
+		Error:        job.GetError(),
+	}
+
+	var jobResult struct {
+		Files []string `json:"files"`
+	}
+
+	if len(job.GetResult()) > 0 {
+		_ = json.Unmarshal([]byte(job.GetResult()), &jobResult)
+		result.Files = jobResult.Files
+	}
+
+	switch {
+	case job.GetStarted() == nil:
+	case job.GetFinished() != nil:
+		result.Duration = durationpb.New(job.GetFinished().AsTime().Sub(job.GetStarted().AsTime()))
+	default:
+		result.Duration = durationpb.New(time.Since(job.GetStarted().AsTime()))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,117 @@
+package grpcserver_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/settings"
+	"github.com/senzing-garage/serve-grpc/grpcserver"
+	szadminpb "github.com/senzing-garage/serve-grpc/proto/go/szadmin"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestGrpcServerImpl_FileExport(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableSzEngine:      true,
+		ExportDirectory:     test.TempDir(),
+		LogLevelName:        "INFO",
+		Port:                8258,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	adminClient := szadminpb.NewSzAdminClient(serveBufconn(test, grpcServer))
+
+	fileExportStatus, err := adminClient.StartFileExport(ctx, &szadminpb.StartFileExportRequest{
+		Format:      szadminpb.FileExportFormat_FILE_EXPORT_FORMAT_NDJSON,
+		Compression: szadminpb.FileExportCompression_FILE_EXPORT_COMPRESSION_GZIP,
+		Name:        "entities",
+	})
+	require.NoError(test, err)
+	require.NotEmpty(test, fileExportStatus.GetJobId())
+
+	require.Eventually(test, func() bool {
+		fileExportStatus, err = adminClient.GetFileExport(
+			ctx,
+			&szadminpb.GetFileExportRequest{JobId: fileExportStatus.GetJobId()},
+		)
+		require.NoError(test, err)
+
+		return fileExportStatus.GetState() != szadminpb.FileExportState_FILE_EXPORT_STATE_RUNNING
+	}, 30*time.Second, 10*time.Millisecond)
+
+	require.Equal(test, szadminpb.FileExportState_FILE_EXPORT_STATE_SUCCEEDED, fileExportStatus.GetState())
+	require.NotNil(test, fileExportStatus.GetFinished())
+	require.Len(test, fileExportStatus.GetFiles(), 1)
+
+	fileInfo, err := os.Stat(fileExportStatus.GetFiles()[0])
+	require.NoError(test, err)
+	require.Equal(test, fileExportStatus.GetBytesWritten(), fileInfo.Size())
+
+	// The same name cannot be used twice, so no export is overwritten.
+
+	fileExportStatus, err = adminClient.StartFileExport(ctx, &szadminpb.StartFileExportRequest{
+		Format:      szadminpb.FileExportFormat_FILE_EXPORT_FORMAT_NDJSON,
+		Compression: szadminpb.FileExportCompression_FILE_EXPORT_COMPRESSION_GZIP,
+		Name:        "entities",
+	})
+	require.NoError(test, err)
+
+	require.Eventually(test, func() bool {
+		fileExportStatus, err = adminClient.GetFileExport(
+			ctx,
+			&szadminpb.GetFileExportRequest{JobId: fileExportStatus.GetJobId()},
+		)
+		require.NoError(test, err)
+
+		return fileExportStatus.GetState() != szadminpb.FileExportState_FILE_EXPORT_STATE_RUNNING
+	}, 30*time.Second, 10*time.Millisecond)
+
+	require.Equal(test, szadminpb.FileExportState_FILE_EXPORT_STATE_FAILED, fileExportStatus.GetState())
+	require.NotEmpty(test, fileExportStatus.GetError())
+
+	_, err = adminClient.GetFileExport(ctx, &szadminpb.GetFileExportRequest{JobId: "no-such-job"})
+	require.Equal(test, codes.NotFound, status.Code(err))
+}
+
+func TestGrpcServerImpl_FileExport_disabled(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableSzEngine:      true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	adminClient := szadminpb.NewSzAdminClient(serveBufconn(test, grpcServer))
+
+	_, err = adminClient.StartFileExport(ctx, &szadminpb.StartFileExportRequest{
+		Format: szadminpb.FileExportFormat_FILE_EXPORT_FORMAT_CSV,
+	})
+	require.Equal(test, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,212 @@
+package grpcserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/serve-grpc/proto/go/szbatch"
+	"github.com/senzing-garage/serve-grpc/proto/go/szbulk"
+	"github.com/senzing-garage/serve-grpc/proto/go/szexport"
+	"github.com/senzing-garage/serve-grpc/szconfigmanagerserver"
+	"github.com/senzing-garage/serve-grpc/szconfigserver"
+	"github.com/senzing-garage/serve-grpc/szdiagnosticserver"
+	"github.com/senzing-garage/serve-grpc/szengineserver"
+	"github.com/senzing-garage/serve-grpc/szproductserver"
+	"github.com/senzing-garage/sz-sdk-proto/go/szconfig"
+	"github.com/senzing-garage/sz-sdk-proto/go/szconfigmanager"
+	"github.com/senzing-garage/sz-sdk-proto/go/szdiagnostic"
+	"github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	"github.com/senzing-garage/sz-sdk-proto/go/szproduct"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A healthProbe is a cheap Senzing SDK call used to decide if a gRPC service is SERVING.
+type healthProbe struct {
+	serviceName string
+	probe       func(ctx context.Context) error
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Register grpc.health.v1.Health and set the initial status of each service.
+func (grpcServer *BasicGrpcServer) enableHealth(ctx context.Context) {
+	grpcServer.healthServer = health.NewServer()
+	grpcServer.healthStatuses = map[string]healthpb.HealthCheckResponse_ServingStatus{}
+	healthChecksStopped := make(chan struct{})
+	grpcServer.healthChecksStopped = healthChecksStopped
+	grpcServer.stopHealthChecks = sync.OnceFunc(func() { close(healthChecksStopped) })
+	healthpb.RegisterHealthServer(grpcServer.grpcserver, grpcServer.healthServer)
+	grpcServer.checkHealth(ctx)
+}
+
+// Probe every enabled service once and publish the results.
+// The overall ("") status is SERVING only if every service is SERVING.
+func (grpcServer *BasicGrpcServer) checkHealth(ctx context.Context) {
+	overallStatus := healthpb.HealthCheckResponse_SERVING
+
+	for _, healthProbe := range grpcServer.healthProbes() {
+		probeCtx, cancel := context.WithTimeout(ctx, grpcServer.getHealthCheckInterval())
+		err := healthProbe.probe(probeCtx)
+
+		cancel()
+
+		status := healthpb.HealthCheckResponse_SERVING
+		if err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+			overallStatus = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+
+		grpcServer.setHealthStatus(healthProbe.serviceName, status, err)
+	}
+
+	grpcServer.setHealthStatus("", overallStatus, nil)
+}
+
+func (grpcServer *BasicGrpcServer) getHealthCheckInterval() time.Duration {
+	if grpcServer.HealthCheckInterval > 0 {
+		return grpcServer.HealthCheckInterval
+	}
+
+	return DefaultHealthCheckInterval
+}
+
+func (grpcServer *BasicGrpcServer) healthProbes() []healthProbe {
+	var result []healthProbe
+
+	if grpcServer.EnableAll || grpcServer.EnableSzConfig {
+		result = append(result, healthProbe{
+			serviceName: szconfig.SzConfig_ServiceDesc.ServiceName,
+			probe: func(ctx context.Context) error {
+				_, err := szconfigserver.GetSdkSzConfigManager().GetDefaultConfigID(ctx)
+
+				return wraperror.Errorf(err, wraperror.NoMessage)
+			},
+		})
+	}
+
+	if grpcServer.EnableAll || grpcServer.EnableSzConfigManager {
+		result = append(result, healthProbe{
+			serviceName: szconfigmanager.SzConfigManager_ServiceDesc.ServiceName,
+			probe: func(ctx context.Context) error {
+				_, err := szconfigmanagerserver.GetSdkSzConfigManager().GetDefaultConfigID(ctx)
+
+				return wraperror.Errorf(err, wraperror.NoMessage)
+			},
+		})
+	}
+
+	if grpcServer.EnableAll || grpcServer.EnableSzDiagnostic {
+		result = append(result, healthProbe{
+			serviceName: szdiagnostic.SzDiagnostic_ServiceDesc.ServiceName,
+			probe: func(ctx context.Context) error {
+				_, err := szdiagnosticserver.GetSdkSzDiagnostic().GetRepositoryInfo(ctx)
+
+				return wraperror.Errorf(err, wraperror.NoMessage)
+			},
+		})
+	}
+
+	if grpcServer.EnableAll || grpcServer.EnableSzEngine {
+		// SzBatch, SzBulk and SzExport serve through the same SzEngine.
+
+		probeSzEngine := func(ctx context.Context) error {
+			_, err := szengineserver.GetSdkSzEngine().GetActiveConfigID(ctx)
+
+			return wraperror.Errorf(err, wraperror.NoMessage)
+		}
+
+		result = append(result,
+			healthProbe{serviceName: szengine.SzEngine_ServiceDesc.ServiceName, probe: probeSzEngine},
+			healthProbe{serviceName: szbatch.SzBatch_ServiceDesc.ServiceName, probe: probeSzEngine},
+			healthProbe{serviceName: szbulk.SzBulk_ServiceDesc.ServiceName, probe: probeSzEngine},
+			healthProbe{serviceName: szexport.SzExport_ServiceDesc.ServiceName, probe: probeSzEngine},
+		)
+	}
+
+	if grpcServer.EnableAll || grpcServer.EnableSzProduct {
+		result = append(result, healthProbe{
+			serviceName: szproduct.SzProduct_ServiceDesc.ServiceName,
+			probe: func(ctx context.Context) error {
+				_, err := szproductserver.GetSdkSzProduct().GetVersion(ctx)
+
+				return wraperror.Errorf(err, wraperror.NoMessage)
+			},
+		})
+	}
+
+	return result
+}
+
+// Publish a status, logging only when it changes.
+func (grpcServer *BasicGrpcServer) setHealthStatus(
+	serviceName string,
+	status healthpb.HealthCheckResponse_ServingStatus,
+	err error,
+) {
+	previousStatus, isKnown := grpcServer.healthStatuses[serviceName]
+	if !isKnown || previousStatus != status {
+		grpcServer.log(2007, serviceName, status, err)
+	}
+
+	grpcServer.healthStatuses[serviceName] = status
+	grpcServer.healthServer.SetServingStatus(serviceName, status)
+}
+
+/*
+Re-probe the services every HealthCheckInterval until stopHealthChecks is called.
+A probe in progress is canceled, and healthChecksDone is closed once the goroutine
+no longer calls the Senzing SDK.
+*/
+func (grpcServer *BasicGrpcServer) startHealthChecks(ctx context.Context) {
+	healthCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	healthChecksDone := make(chan struct{})
+	grpcServer.healthChecksDone = healthChecksDone
+
+	go func() {
+		select {
+		case <-grpcServer.healthChecksStopped:
+		case <-healthChecksDone:
+		}
+
+		cancel()
+	}()
+
+	go func() {
+		ticker := time.NewTicker(grpcServer.getHealthCheckInterval())
+
+		defer func() {
+			ticker.Stop()
+			close(healthChecksDone)
+		}()
+
+		for {
+			select {
+			case <-grpcServer.healthChecksStopped:
+				return
+			case <-ticker.C:
+				grpcServer.checkHealth(healthCtx)
+			}
+		}
+	}()
+}
+
+// Wait until the health checks started by startHealthChecks have stopped, or ctx is done.
+func (grpcServer *BasicGrpcServer) waitHealthChecks(ctx context.Context) {
+	if grpcServer.healthChecksDone == nil {
+		return
+	}
+
+	select {
+	case <-grpcServer.healthChecksDone:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,730 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	szadminpb "github.com/senzing-garage/serve-grpc/proto/go/szadmin"
+	szjobpb "github.com/senzing-garage/serve-grpc/proto/go/szjob"
+	"github.com/senzing-garage/serve-grpc/szdiagnosticserver"
+	"github.com/senzing-garage/serve-grpc/szengineserver"
+	"github.com/senzing-garage/sz-sdk-proto/go/szdiagnostic"
+	"github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+Jobs configures the szjob.SzJob service.
+
+Jobs belong to the principal that submitted them: other callers can neither see nor
+cancel them, unless the AccessPolicy allows them "/szjob.SzJob/ListAllJobs".  That
+method cannot be called; it only names the permission, so patterns such as
+"/szjob.SzJob/*" grant it too.  Submitting a job needs the same access as calling its
+operation directly.
+
+MaxRunning jobs run at the same time; later jobs wait.  The newest MaxFinished
+finished jobs are remembered.  If StateFile is set, jobs are saved to it whenever
+they change and loaded from it at startup.  Jobs that were pending or running when
+the server stopped are loaded as failed, because they cannot be resumed.
+*/
+type Jobs struct {
+	MaxFinished int
+	MaxRunning  int
+	StateFile   string
+}
+
+// jobServer implements the szjob.SzJob service for a BasicGrpcServer.
+type jobServer struct {
+	szjobpb.UnimplementedSzJobServer
+	grpcServer *BasicGrpcServer
+}
+
+// jobManager runs and remembers jobs.  Its mutex is locked before any job's.
+type jobManager struct {
+	config      Jobs
+	grpcServer  *BasicGrpcServer
+	jobs        map[string]*job
+	mutex       sync.Mutex
+	running     sync.WaitGroup
+	saveMutex   sync.Mutex
+	slots       chan struct{}
+	stopped     bool
+	stoppedJobs context.Context //nolint:containedctx // Canceled to stop every job.
+	stopJobs    context.CancelFunc
+}
+
+// job is one job.  changed is closed and replaced whenever record changes.
+type job struct {
+	cancel   context.CancelFunc
+	changed  chan struct{}
+	mutex    sync.Mutex
+	progress func(record *jobRecord) // Refreshes Progress and Result of a running job; may be nil.
+	record   jobRecord
+	run      jobRunner
+}
+
+// jobRecord is the state of a job saved to Jobs.StateFile.
+type jobRecord struct {
+	Error     string           `json:"error,omitempty"`
+	ErrorCode codes.Code       `json:"errorCode,omitempty"`
+	Finished  time.Time        `json:"finished,omitzero"`
+	ID        string           `json:"id"`
+	Kind      string           `json:"kind"`
+	Owner     string           `json:"owner,omitempty"`
+	Progress  map[string]int64 `json:"progress,omitempty"`
+	Request   json.RawMessage  `json:"request"`
+	Result    string           `json:"result,omitempty"`
+	Started   time.Time        `json:"started,omitzero"`
+	State     szjobpb.JobState `json:"state"`
+	Submitted time.Time        `json:"submitted"`
+}
+
+// A jobRunner performs the operation of a job and returns its JSON result.
+type jobRunner func(ctx context.Context, job *job) (string, error)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Default number of finished jobs remembered.
+const DefaultMaxFinishedJobs = 1000
+
+// Default number of jobs running at the same time.
+const DefaultMaxRunningJobs = 2
+
+// Job kinds.
+const (
+	jobKindCheckRepositoryPerformance = "checkRepositoryPerformance"
+	jobKindExportFile                 = "exportFile"
+	jobKindPrimeEngine                = "primeEngine"
+	jobKindPurgeRepository            = "purgeRepository"
+)
+
+// Callers allowed this method may see and cancel every owner's jobs.  No RPC has this name.
+const jobAdminMethod = "/szjob.SzJob/ListAllJobs"
+
+// Random bytes in a job id.
+const jobIDSize = 8
+
+// Time between WatchJob messages about the progress of a running job.
+const jobProgressInterval = time.Second
+
+// ----------------------------------------------------------------------------
+// Interface methods for github.com/senzing-garage/serve-grpc/proto/go/szjob.SzJobServer
+// ----------------------------------------------------------------------------
+
+func (server *jobServer) CancelJob(
+	ctx context.Context,
+	request *szjobpb.CancelJobRequest,
+) (*szjobpb.Job, error) {
+	job, err := server.grpcServer.jobManager.get(ctx, request.GetJobId())
+	if err != nil {
+		return nil, err
+	}
+
+	job.cancel()
+
+	return job.snapshot(), nil
+}
+
+func (server *jobServer) GetJob(
+	ctx context.Context,
+	request *szjobpb.GetJobRequest,
+) (*szjobpb.Job, error) {
+	job, err := server.grpcServer.jobManager.get(ctx, request.GetJobId())
+	if err != nil {
+		return nil, err
+	}
+
+	return job.snapshot(), nil
+}
+
+func (server *jobServer) ListJobs(
+	ctx context.Context,
+	request *szjobpb.ListJobsRequest,
+) (*szjobpb.ListJobsResponse, error) {
+	return &szjobpb.ListJobsResponse{Jobs: server.grpcServer.jobManager.list(ctx, request.GetState())}, nil
+}
+
+func (server *jobServer) SubmitJob(
+	ctx context.Context,
+	request *szjobpb.SubmitJobRequest,
+) (*szjobpb.Job, error) {
+	job, err := server.grpcServer.jobManager.submit(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return job.snapshot(), nil
+}
+
+func (server *jobServer) WatchJob(
+	request *szjobpb.WatchJobRequest,
+	stream szjobpb.SzJob_WatchJobServer,
+) error {
+	job, err := server.grpcServer.jobManager.get(stream.Context(), request.GetJobId())
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(jobProgressInterval)
+	defer ticker.Stop()
+
+	var sent *szjobpb.Job
+
+	for {
+		job.mutex.Lock()
+		changed := job.changed
+		job.mutex.Unlock()
+
+		current := job.snapshot()
+		if !proto.Equal(current, sent) {
+			err = stream.Send(current)
+			if err != nil {
+				return wraperror.Errorf(err, "stream.Send")
+			}
+
+			sent = current
+		}
+
+		if isJobFinished(current.GetState()) {
+			return nil
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return wraperror.Errorf(stream.Context().Err(), "stream.Context")
+		case <-changed:
+		case <-ticker.C:
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Add SzJob service to gRPC server.
+func (grpcServer *BasicGrpcServer) enableSzJob() error {
+	manager, err := grpcServer.newJobManager()
+	if err != nil {
+		return err
+	}
+
+	grpcServer.jobManager = manager
+	szjobpb.RegisterSzJobServer(grpcServer.grpcserver, &jobServer{grpcServer: grpcServer})
+
+	return nil
+}
+
+// Create the job manager, loading the jobs of Jobs.StateFile if it exists.
+func (grpcServer *BasicGrpcServer) newJobManager() (*jobManager, error) {
+	result := &jobManager{
+		config:     grpcServer.Jobs,
+		grpcServer: grpcServer,
+		jobs:       map[string]*job{},
+	}
+
+	if result.config.MaxFinished <= 0 {
+		result.config.MaxFinished = DefaultMaxFinishedJobs
+	}
+
+	if result.config.MaxRunning <= 0 {
+		result.config.MaxRunning = DefaultMaxRunningJobs
+	}
+
+	result.slots = make(chan struct{}, result.config.MaxRunning)
+	result.stoppedJobs, result.stopJobs = context.WithCancel(context.Background())
+
+	err := result.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+/*
+Check a SubmitJobRequest and get what the job does.  Requests the server cannot run,
+or that the caller of ctx may not make, are reported as gRPC status errors.
+*/
+func (grpcServer *BasicGrpcServer) newJobRunner(
+	ctx context.Context,
+	jobID string,
+	request *szjobpb.SubmitJobRequest,
+) (string, jobRunner, error) {
+	isDiagnosticEnabled := grpcServer.EnableAll || grpcServer.EnableSzDiagnostic
+	isEngineEnabled := grpcServer.EnableAll || grpcServer.EnableSzEngine
+
+	switch operation := request.GetOperation().(type) {
+	case *szjobpb.SubmitJobRequest_CheckRepositoryPerformance:
+		err := grpcServer.authorizeCall(
+			ctx,
+			szdiagnostic.SzDiagnostic_CheckRepositoryPerformance_FullMethodName,
+			operation.CheckRepositoryPerformance,
+		)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if !isDiagnosticEnabled {
+			return "", nil, jobNeedsServiceError("SzDiagnostic")
+		}
+
+		runner := func(ctx context.Context, _ *job) (string, error) {
+			secondsToRun := int(operation.CheckRepositoryPerformance.GetSecondsToRun())
+			result, err := szdiagnosticserver.GetSdkSzDiagnostic().CheckRepositoryPerformance(ctx, secondsToRun)
+
+			return result, wraperror.Errorf(err, "CheckRepositoryPerformance")
+		}
+
+		return jobKindCheckRepositoryPerformance, runner, nil
+	case *szjobpb.SubmitJobRequest_PurgeRepository:
+		err := grpcServer.authorizeCall(ctx, szdiagnostic.SzDiagnostic_PurgeRepository_FullMethodName, operation.PurgeRepository)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if !isDiagnosticEnabled {
+			return "", nil, jobNeedsServiceError("SzDiagnostic")
+		}
+
+		if grpcServer.ReadOnly {
+			return "", nil, readOnlyError(szdiagnostic.SzDiagnostic_PurgeRepository_FullMethodName)
+		}
+
+		runner := func(ctx context.Context, _ *job) (string, error) {
+			err := szdiagnosticserver.GetSdkSzDiagnostic().PurgeRepository(ctx)
+
+			return "", wraperror.Errorf(err, "PurgeRepository")
+		}
+
+		return jobKindPurgeRepository, runner, nil
+	case *szjobpb.SubmitJobRequest_PrimeEngine:
+		err := grpcServer.authorizeCall(ctx, szengine.SzEngine_PrimeEngine_FullMethodName, operation.PrimeEngine)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if !isEngineEnabled {
+			return "", nil, jobNeedsServiceError("SzEngine")
+		}
+
+		runner := func(ctx context.Context, _ *job) (string, error) {
+			err := szengineserver.GetSdkSzEngine().PrimeEngine(ctx)
+
+			return "", wraperror.Errorf(err, "PrimeEngine")
+		}
+
+		return jobKindPrimeEngine, runner, nil
+	case *szjobpb.SubmitJobRequest_ExportFile:
+		err := grpcServer.authorizeCall(ctx, szadminpb.SzAdmin_StartFileExport_FullMethodName, operation.ExportFile)
+		if err != nil {
+			return "", nil, err
+		}
+
+		runner, err := grpcServer.newFileExportRunner(jobID, operation.ExportFile)
+
+		return jobKindExportFile, runner, err
+	default:
+		return "", nil, status.Error(codes.InvalidArgument, "the request has no operation")
+	}
+}
+
+// Decide if the caller of ctx may see and cancel the jobs of every owner.
+func (grpcServer *BasicGrpcServer) isJobAdmin(ctx context.Context) bool {
+	principal, isAuthenticated := PrincipalFromContext(ctx)
+	if grpcServer.AccessPolicy == nil || !isAuthenticated {
+		return false
+	}
+
+	grant := grpcServer.AccessPolicy.grant(principal, jobAdminMethod)
+
+	return grant.allowed && grant.dataSources == nil
+}
+
+// Queue a job.  It runs in the background until it ends or the server stops.
+func (manager *jobManager) submit(ctx context.Context, request *szjobpb.SubmitJobRequest) (*job, error) {
+	jobID := newJobID()
+
+	kind, runner, err := manager.grpcServer.newJobRunner(ctx, jobID, request)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedRequest, err := protojson.Marshal(request)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "protojson.Marshal")
+	}
+
+	result := &job{
+		changed: make(chan struct{}),
+		record: jobRecord{
+			ID:        jobID,
+			Kind:      kind,
+			Owner:     exportOwner(ctx),
+			Request:   encodedRequest,
+			State:     szjobpb.JobState_JOB_STATE_PENDING,
+			Submitted: time.Now(),
+		},
+		run: runner,
+	}
+
+	manager.mutex.Lock()
+
+	if manager.stopped {
+		manager.mutex.Unlock()
+
+		return nil, status.Error(codes.Unavailable, "the server is shutting down")
+	}
+
+	jobCtx, cancel := context.WithCancel(manager.stoppedJobs)
+	result.cancel = cancel
+	manager.jobs[jobID] = result
+	manager.running.Add(1)
+
+	manager.mutex.Unlock()
+
+	manager.grpcServer.log(2014, jobID, kind, result.record.Owner)
+	manager.save()
+
+	go func() {
+		defer manager.running.Done()
+
+		manager.runJob(jobCtx, result)
+	}()
+
+	return result, nil
+}
+
+// Wait for a free slot, run job and record how it ended.
+func (manager *jobManager) runJob(ctx context.Context, job *job) {
+	defer job.cancel()
+
+	select {
+	case <-ctx.Done():
+		manager.finishJob(ctx, job, "", wraperror.Errorf(ctx.Err(), "canceled while pending"))
+
+		return
+	case manager.slots <- struct{}{}:
+	}
+
+	defer func() { <-manager.slots }()
+
+	if ctx.Err() != nil {
+		manager.finishJob(ctx, job, "", wraperror.Errorf(ctx.Err(), "canceled while pending"))
+
+		return
+	}
+
+	job.update(func(record *jobRecord) {
+		record.State = szjobpb.JobState_JOB_STATE_RUNNING
+		record.Started = time.Now()
+	})
+	manager.save()
+
+	result, err := job.run(ctx, job)
+	manager.finishJob(ctx, job, result, err)
+}
+
+func (manager *jobManager) finishJob(ctx context.Context, job *job, result string, err error) {
+	job.update(func(record *jobRecord) {
+		if job.progress != nil && !record.Started.IsZero() {
+			job.progress(record)
+		}
+
+		record.Finished = time.Now()
+
+		if len(result) > 0 {
+			record.Result = result
+		}
+
+		switch {
+		case err == nil:
+			record.State = szjobpb.JobState_JOB_STATE_SUCCEEDED
+		case ctx.Err() != nil:
+			record.State = szjobpb.JobState_JOB_STATE_CANCELED
+			record.Error = err.Error()
+			record.ErrorCode = codes.Canceled
+		default:
+			record.State = szjobpb.JobState_JOB_STATE_FAILED
+			record.Error = err.Error()
+			record.ErrorCode = status.Code(StatusFromError(err))
+		}
+	})
+
+	if err != nil && ctx.Err() == nil {
+		manager.grpcServer.log(3007, job.record.ID, job.record.Kind, err)
+	} else {
+		manager.grpcServer.log(2015, job.record.ID, job.record.Kind, job.snapshot().GetState())
+	}
+
+	manager.forgetFinishedJobs()
+	manager.save()
+}
+
+/*
+Get a job the caller of ctx may see.  Unknown jobs and jobs of other owners are both
+reported as NotFound, so that job ids cannot be probed.
+*/
+func (manager *jobManager) get(ctx context.Context, jobID string) (*job, error) {
+	isAdmin := manager.grpcServer.isJobAdmin(ctx)
+	owner := exportOwner(ctx)
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	result, isFound := manager.jobs[jobID]
+	if !isFound || (!isAdmin && result.record.Owner != owner) {
+		return nil, status.Errorf(codes.NotFound, "job %q does not exist", jobID)
+	}
+
+	return result, nil
+}
+
+// List the jobs the caller of ctx may see in state, or in any state if state is unspecified, newest first.
+func (manager *jobManager) list(ctx context.Context, state szjobpb.JobState) []*szjobpb.Job {
+	result := []*szjobpb.Job{}
+	isAdmin := manager.grpcServer.isJobAdmin(ctx)
+	owner := exportOwner(ctx)
+
+	for _, job := range manager.sortedJobs() {
+		if !isAdmin && job.record.Owner != owner {
+			continue
+		}
+
+		snapshot := job.snapshot()
+		if state == szjobpb.JobState_JOB_STATE_UNSPECIFIED || snapshot.GetState() == state {
+			result = append(result, snapshot)
+		}
+	}
+
+	return result
+}
+
+// Cancel every job and wait for them to end until ctx is done.
+func (manager *jobManager) stop(ctx context.Context) {
+	manager.mutex.Lock()
+	manager.stopped = true
+	manager.mutex.Unlock()
+
+	manager.stopJobs()
+
+	jobsEnded := make(chan struct{})
+
+	go func() {
+		manager.running.Wait()
+		close(jobsEnded)
+	}()
+
+	select {
+	case <-jobsEnded:
+	case <-ctx.Done():
+		manager.grpcServer.log(3010, context.Cause(ctx))
+	}
+}
+
+// Forget the oldest finished jobs beyond Jobs.MaxFinished.
+func (manager *jobManager) forgetFinishedJobs() {
+	finished := 0
+
+	for _, job := range manager.sortedJobs() {
+		if !isJobFinished(job.snapshot().GetState()) {
+			continue
+		}
+
+		finished++
+		if finished > manager.config.MaxFinished {
+			manager.mutex.Lock()
+			delete(manager.jobs, job.record.ID)
+			manager.mutex.Unlock()
+		}
+	}
+}
+
+// The jobs, newest first.
+func (manager *jobManager) sortedJobs() []*job {
+	manager.mutex.Lock()
+	result := slices.Collect(maps.Values(manager.jobs))
+	manager.mutex.Unlock()
+
+	slices.SortFunc(result, func(a, b *job) int { return b.record.Submitted.Compare(a.record.Submitted) })
+
+	return result
+}
+
+// Load the jobs of Jobs.StateFile.  Jobs that did not end are marked as failed.
+func (manager *jobManager) load() error {
+	if len(manager.config.StateFile) == 0 {
+		return nil
+	}
+
+	contents, err := os.ReadFile(manager.config.StateFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return wraperror.Errorf(err, "ReadFile")
+	}
+
+	var records []jobRecord
+
+	err = json.Unmarshal(contents, &records)
+	if err != nil {
+		return wraperror.Errorf(err, "Unmarshal %s", manager.config.StateFile)
+	}
+
+	now := time.Now()
+
+	for _, record := range records {
+		if !isJobFinished(record.State) {
+			record.State = szjobpb.JobState_JOB_STATE_FAILED
+			record.Error = "the server stopped before the job ended"
+			record.ErrorCode = codes.Aborted
+			record.Finished = now
+		}
+
+		manager.jobs[record.ID] = &job{cancel: func() {}, changed: make(chan struct{}), record: record}
+	}
+
+	return nil
+}
+
+// Write every job to Jobs.StateFile, replacing it atomically.
+func (manager *jobManager) save() {
+	if len(manager.config.StateFile) == 0 {
+		return
+	}
+
+	manager.saveMutex.Lock()
+	defer manager.saveMutex.Unlock()
+
+	jobs := manager.sortedJobs()
+	records := make([]jobRecord, 0, len(jobs))
+
+	for _, job := range jobs {
+		job.mutex.Lock()
+		records = append(records, job.record)
+		job.mutex.Unlock()
+	}
+
+	err := writeFileAtomically(manager.config.StateFile, records)
+	if err != nil {
+		manager.grpcServer.log(3008, manager.config.StateFile, err)
+	}
+}
+
+// The job as an szjob.Job, with the latest progress if it is running.
+func (job *job) snapshot() *szjobpb.Job {
+	job.mutex.Lock()
+	defer job.mutex.Unlock()
+
+	if job.progress != nil && job.record.State == szjobpb.JobState_JOB_STATE_RUNNING {
+		job.progress(&job.record)
+	}
+
+	record := job.record
+	result := &szjobpb.Job{
+		JobId:     record.ID,
+		Kind:      record.Kind,
+		State:     record.State,
+		Owner:     record.Owner,
+		Submitted: timestamppb.New(record.Submitted),
+		Progress:  maps.Clone(record.Progress),
+		Result:    record.Result,
+		Error:     record.Error,
+		ErrorCode: int32(record.ErrorCode), //nolint:gosec // gRPC codes are small.
+		Request:   &szjobpb.SubmitJobRequest{},
+	}
+
+	if !record.Started.IsZero() {
+		result.Started = timestamppb.New(record.Started)
+	}
+
+	if !record.Finished.IsZero() {
+		result.Finished = timestamppb.New(record.Finished)
+	}
+
+	_ = protojson.Unmarshal(record.Request, result.Request)
+
+	return result
+}
+
+// Change the record of job and wake its watchers.
+func (job *job) update(change func(record *jobRecord)) {
+	job.mutex.Lock()
+	defer job.mutex.Unlock()
+
+	change(&job.record)
+	close(job.changed)
+	job.changed = make(chan struct{})
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func isJobFinished(state szjobpb.JobState) bool {
+	switch state {
+	case szjobpb.JobState_JOB_STATE_SUCCEEDED, szjobpb.JobState_JOB_STATE_FAILED, szjobpb.JobState_JOB_STATE_CANCELED:
+		return true
+	default:
+		return false
+	}
+}
+
+func jobNeedsServiceError(service string) error {
+	return status.Errorf(codes.FailedPrecondition, "the job needs the %s service, which is not enabled on this server", service)
+}
+
+func newJobID() string {
+	buffer := make([]byte, jobIDSize)
+	_, _ = rand.Read(buffer)
+
+	return hex.EncodeToString(buffer)
+}
+
+// Write value as JSON to a temporary file and rename it to path.
+func writeFileAtomically(path string, value any) error {
+	contents, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return wraperror.Errorf(err, "MarshalIndent")
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return wraperror.Errorf(err, "CreateTemp")
+	}
+
+	_, err = file.Write(contents)
+	err = errors.Join(err, file.Close())
+
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+
+	if err != nil {
+		_ = os.Remove(file.Name())
+	}
+
+	return wraperror.Errorf(err, "writing %s", path)
+}
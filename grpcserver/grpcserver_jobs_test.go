@@ -0,0 +1,277 @@
+package grpcserver_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/senzing-garage/go-helpers/settings"
+	"github.com/senzing-garage/serve-grpc/grpcserver"
+	szjobpb "github.com/senzing-garage/serve-grpc/proto/go/szjob"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestGrpcServerImpl_Jobs(test *testing.T) {
+	ctx := test.Context()
+	stateFile := filepath.Join(test.TempDir(), "jobs.json")
+	jobClient := szjobpb.NewSzJobClient(serveBufconn(test, initializeJobServer(test, grpcserver.Jobs{StateFile: stateFile})))
+
+	job, err := jobClient.SubmitJob(ctx, &szjobpb.SubmitJobRequest{
+		Operation: &szjobpb.SubmitJobRequest_PrimeEngine{PrimeEngine: &szjobpb.PrimeEngine{}},
+	})
+	require.NoError(test, err)
+	require.NotEmpty(test, job.GetJobId())
+	require.Equal(test, "primeEngine", job.GetKind())
+	require.NotNil(test, job.GetRequest().GetPrimeEngine())
+
+	// WatchJob ends after sending the finished job.
+
+	stream, err := jobClient.WatchJob(ctx, &szjobpb.WatchJobRequest{JobId: job.GetJobId()})
+	require.NoError(test, err)
+
+	var watched *szjobpb.Job
+
+	for {
+		message, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+
+		require.NoError(test, err)
+
+		watched = message
+	}
+
+	require.Equal(test, szjobpb.JobState_JOB_STATE_SUCCEEDED, watched.GetState())
+	require.NotNil(test, watched.GetFinished())
+
+	jobs, err := jobClient.ListJobs(ctx, &szjobpb.ListJobsRequest{State: szjobpb.JobState_JOB_STATE_SUCCEEDED})
+	require.NoError(test, err)
+	require.Len(test, jobs.GetJobs(), 1)
+
+	jobs, err = jobClient.ListJobs(ctx, &szjobpb.ListJobsRequest{State: szjobpb.JobState_JOB_STATE_RUNNING})
+	require.NoError(test, err)
+	require.Empty(test, jobs.GetJobs())
+
+	// Canceling a finished job does not change it.
+
+	job, err = jobClient.CancelJob(ctx, &szjobpb.CancelJobRequest{JobId: watched.GetJobId()})
+	require.NoError(test, err)
+	require.Equal(test, szjobpb.JobState_JOB_STATE_SUCCEEDED, job.GetState())
+
+	// A server using the same state file remembers the job.
+
+	jobClient = szjobpb.NewSzJobClient(serveBufconn(test, initializeJobServer(test, grpcserver.Jobs{StateFile: stateFile})))
+
+	job, err = jobClient.GetJob(ctx, &szjobpb.GetJobRequest{JobId: watched.GetJobId()})
+	require.NoError(test, err)
+	require.Equal(test, szjobpb.JobState_JOB_STATE_SUCCEEDED, job.GetState())
+}
+
+func TestGrpcServerImpl_Jobs_interrupted(test *testing.T) {
+	ctx := test.Context()
+	stateFile := filepath.Join(test.TempDir(), "jobs.json")
+
+	err := os.WriteFile(stateFile, []byte(`[{
+		"id": "0123456789abcdef",
+		"kind": "primeEngine",
+		"request": {"primeEngine": {}},
+		"started": "2026-01-02T03:04:05Z",
+		"state": 2,
+		"submitted": "2026-01-02T03:04:05Z"
+	}]`), 0o600)
+	require.NoError(test, err)
+
+	jobClient := szjobpb.NewSzJobClient(serveBufconn(test, initializeJobServer(test, grpcserver.Jobs{StateFile: stateFile})))
+
+	job, err := jobClient.GetJob(ctx, &szjobpb.GetJobRequest{JobId: "0123456789abcdef"})
+	require.NoError(test, err)
+	require.Equal(test, szjobpb.JobState_JOB_STATE_FAILED, job.GetState())
+	require.Equal(test, int32(codes.Aborted), job.GetErrorCode())
+	require.NotNil(test, job.GetRequest().GetPrimeEngine())
+}
+
+func TestGrpcServerImpl_Jobs_rejected(test *testing.T) {
+	ctx := test.Context()
+	jobClient := szjobpb.NewSzJobClient(serveBufconn(test, initializeJobServer(test, grpcserver.Jobs{})))
+
+	_, err := jobClient.GetJob(ctx, &szjobpb.GetJobRequest{JobId: "no-such-job"})
+	require.Equal(test, codes.NotFound, status.Code(err))
+
+	_, err = jobClient.CancelJob(ctx, &szjobpb.CancelJobRequest{JobId: "no-such-job"})
+	require.Equal(test, codes.NotFound, status.Code(err))
+
+	_, err = jobClient.SubmitJob(ctx, &szjobpb.SubmitJobRequest{})
+	require.Equal(test, codes.InvalidArgument, status.Code(err))
+
+	// SzDiagnostic is not enabled.
+
+	_, err = jobClient.SubmitJob(ctx, &szjobpb.SubmitJobRequest{
+		Operation: &szjobpb.SubmitJobRequest_CheckRepositoryPerformance{
+			CheckRepositoryPerformance: &szjobpb.CheckRepositoryPerformance{SecondsToRun: 1},
+		},
+	})
+	require.Equal(test, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestGrpcServerImpl_Jobs_owners(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	authenticator, err := grpcserver.NewAPIKeyAuthenticator(writeTestFile(test, "api-keys.json", `[
+		{"name": "alice", "key": "alice-key", "roles": ["operator"]},
+		{"name": "bob", "key": "bob-key", "roles": ["operator"]},
+		{"name": "carol", "key": "carol-key", "roles": ["watcher"]},
+		{"name": "dave", "key": "dave-key", "roles": ["jobadmin"]},
+		{"name": "erin", "key": "erin-key", "roles": ["watcher", "exports"]},
+		{"name": "root", "key": "root-key", "roles": ["admin"]}
+	]`))
+	require.NoError(test, err)
+
+	accessPolicy, err := grpcserver.NewAccessPolicy(writeTestFile(test, "access-policy.json", `{"rules": [
+		{"roles": ["admin"], "methods": ["/*/*"]},
+		{"roles": ["exports"], "methods": ["/szadmin.SzAdmin/ListExports"]},
+		{"roles": ["jobadmin"], "methods": ["/szjob.SzJob/*"]},
+		{"roles": ["operator"], "methods": ["/szengine.SzEngine/PrimeEngine"]},
+		{"roles": ["operator", "watcher"], "methods": [
+			"/szjob.SzJob/CancelJob",
+			"/szjob.SzJob/GetJob",
+			"/szjob.SzJob/ListJobs",
+			"/szjob.SzJob/SubmitJob",
+			"/szjob.SzJob/WatchJob"
+		]}
+	]}`))
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AccessPolicy:        accessPolicy,
+		Authenticators:      []grpcserver.Authenticator{authenticator},
+		AvoidServing:        true,
+		EnableSzEngine:      true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	jobClient := szjobpb.NewSzJobClient(serveBufconn(test, grpcServer))
+	aliceCtx := metadata.AppendToOutgoingContext(ctx, "x-api-key", "alice-key")
+	bobCtx := metadata.AppendToOutgoingContext(ctx, "x-api-key", "bob-key")
+	carolCtx := metadata.AppendToOutgoingContext(ctx, "x-api-key", "carol-key")
+	daveCtx := metadata.AppendToOutgoingContext(ctx, "x-api-key", "dave-key")
+	erinCtx := metadata.AppendToOutgoingContext(ctx, "x-api-key", "erin-key")
+	rootCtx := metadata.AppendToOutgoingContext(ctx, "x-api-key", "root-key")
+	primeEngine := &szjobpb.SubmitJobRequest{
+		Operation: &szjobpb.SubmitJobRequest_PrimeEngine{PrimeEngine: &szjobpb.PrimeEngine{}},
+	}
+
+	// Submitting a job needs access to the method of its operation.
+
+	_, err = jobClient.SubmitJob(carolCtx, primeEngine)
+	require.Equal(test, codes.PermissionDenied, status.Code(err))
+
+	job, err := jobClient.SubmitJob(aliceCtx, primeEngine)
+	require.NoError(test, err)
+	require.NotEmpty(test, job.GetOwner())
+
+	// Other callers cannot see or cancel the job, even if they may list every export.
+
+	for _, callerCtx := range []context.Context{bobCtx, erinCtx} {
+		_, err = jobClient.GetJob(callerCtx, &szjobpb.GetJobRequest{JobId: job.GetJobId()})
+		require.Equal(test, codes.NotFound, status.Code(err))
+	}
+
+	_, err = jobClient.CancelJob(bobCtx, &szjobpb.CancelJobRequest{JobId: job.GetJobId()})
+	require.Equal(test, codes.NotFound, status.Code(err))
+
+	stream, err := jobClient.WatchJob(bobCtx, &szjobpb.WatchJobRequest{JobId: job.GetJobId()})
+	require.NoError(test, err)
+
+	_, err = stream.Recv()
+	require.Equal(test, codes.NotFound, status.Code(err))
+
+	jobs, err := jobClient.ListJobs(bobCtx, &szjobpb.ListJobsRequest{})
+	require.NoError(test, err)
+	require.Empty(test, jobs.GetJobs())
+
+	// The owner and callers allowed "/szjob.SzJob/ListAllJobs" can.
+
+	for _, callerCtx := range []context.Context{aliceCtx, daveCtx, rootCtx} {
+		_, err = jobClient.GetJob(callerCtx, &szjobpb.GetJobRequest{JobId: job.GetJobId()})
+		require.NoError(test, err)
+
+		jobs, err = jobClient.ListJobs(callerCtx, &szjobpb.ListJobsRequest{})
+		require.NoError(test, err)
+		require.Len(test, jobs.GetJobs(), 1)
+	}
+
+	_, err = jobClient.CancelJob(rootCtx, &szjobpb.CancelJobRequest{JobId: job.GetJobId()})
+	require.NoError(test, err)
+}
+
+func TestGrpcServerImpl_Jobs_readOnly(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableSzDiagnostic:  true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		ReadOnly:            true,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	jobClient := szjobpb.NewSzJobClient(serveBufconn(test, grpcServer))
+
+	_, err = jobClient.SubmitJob(ctx, &szjobpb.SubmitJobRequest{
+		Operation: &szjobpb.SubmitJobRequest_PurgeRepository{PurgeRepository: &szjobpb.PurgeRepository{}},
+	})
+	require.Equal(test, codes.FailedPrecondition, status.Code(err))
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+func initializeJobServer(test *testing.T, jobs grpcserver.Jobs) *grpcserver.BasicGrpcServer {
+	test.Helper()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	result := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableSzEngine:      true,
+		Jobs:                jobs,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = result.Initialize(test.Context())
+	require.NoError(test, err)
+
+	return result
+}
@@ -0,0 +1,184 @@
+package grpcserver
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// grpcMetrics holds the Prometheus collectors updated by the metrics interceptors.
+type grpcMetrics struct {
+	errors         *prometheus.CounterVec
+	inFlight       *prometheus.GaugeVec
+	latency        *prometheus.HistogramVec
+	registry       *prometheus.Registry
+	requests       *prometheus.CounterVec
+	streamMessages *prometheus.CounterVec
+}
+
+// metricsServerStream counts the messages sent on a server stream.
+// For StreamExportCsvEntityReport and StreamExportJsonEntityReport each message is one exported row.
+type metricsServerStream struct {
+	grpc.ServerStream
+	messagesSent prometheus.Counter
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const metricsNamespace = "serve_grpc"
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+The GetMetricsHandler method returns an http.Handler serving the gRPC metrics in
+Prometheus text format.
+
+Output
+  - An http.Handler, or nil if BasicGrpcServer.Initialize() has not been called.
+*/
+func (grpcServer *BasicGrpcServer) GetMetricsHandler() http.Handler {
+	if grpcServer.metrics == nil {
+		return nil
+	}
+
+	return promhttp.HandlerFor(grpcServer.metrics.registry, promhttp.HandlerOpts{})
+}
+
+// SendMsg counts each message successfully sent to the client.
+func (stream *metricsServerStream) SendMsg(message any) error {
+	err := stream.ServerStream.SendMsg(message)
+	if err == nil {
+		stream.messagesSent.Inc()
+	}
+
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Record request count, error count by gRPC code, latency and in-flight calls for unary RPCs.
+func (grpcServer *BasicGrpcServer) metricsUnaryInterceptor(
+	ctx context.Context,
+	request any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	service, method := splitFullMethod(info.FullMethod)
+	done := grpcServer.metrics.begin(service, method)
+	response, err := handler(ctx, request)
+	done(err)
+
+	return response, err
+}
+
+// Record request count, error count by gRPC code, latency, in-flight calls and messages sent for streaming RPCs.
+func (grpcServer *BasicGrpcServer) metricsStreamInterceptor(
+	server any,
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	service, method := splitFullMethod(info.FullMethod)
+	done := grpcServer.metrics.begin(service, method)
+	err := handler(server, &metricsServerStream{
+		ServerStream: stream,
+		messagesSent: grpcServer.metrics.streamMessages.WithLabelValues(service, method),
+	})
+	done(err)
+
+	return err
+}
+
+// Mark the start of a call.  The returned function records the outcome.
+func (metrics *grpcMetrics) begin(service string, method string) func(err error) {
+	entryTime := time.Now()
+	inFlight := metrics.inFlight.WithLabelValues(service, method)
+
+	inFlight.Inc()
+	metrics.requests.WithLabelValues(service, method).Inc()
+
+	return func(err error) {
+		inFlight.Dec()
+		metrics.latency.WithLabelValues(service, method).Observe(time.Since(entryTime).Seconds())
+
+		if err != nil {
+			metrics.errors.WithLabelValues(service, method, status.Code(err).String()).Inc()
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func newGrpcMetrics() *grpcMetrics {
+	methodLabels := []string{"service", "method"}
+	result := &grpcMetrics{
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "errors_total",
+			Help:      "Number of gRPC calls that returned an error, by gRPC status code.",
+		}, []string{"service", "method", "code"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "requests_in_flight",
+			Help:      "Number of gRPC calls currently being handled.",
+		}, methodLabels),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "request_duration_seconds",
+			Help:      "Time taken to handle a gRPC call, including the full lifetime of streams.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 4, 10),
+		}, methodLabels),
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "requests_total",
+			Help:      "Number of gRPC calls received.",
+		}, methodLabels),
+		streamMessages: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "stream_messages_sent_total",
+			Help:      "Number of messages sent on server streams. For entity report exports, one message per row.",
+		}, methodLabels),
+	}
+
+	result.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		result.errors,
+		result.inFlight,
+		result.latency,
+		result.requests,
+		result.streamMessages,
+	)
+
+	return result
+}
+
+// Split "/szengine.SzEngine/AddRecord" into "szengine.SzEngine" and "AddRecord".
+func splitFullMethod(fullMethod string) (string, string) {
+	service, method, found := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !found {
+		return "unknown", fullMethod
+	}
+
+	return service, method
+}
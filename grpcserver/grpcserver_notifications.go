@@ -0,0 +1,134 @@
+package grpcserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/go-observing/observer"
+	szadminpb "github.com/senzing-garage/serve-grpc/proto/go/szadmin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+A notificationFeed is an observer of the Senzing SDK that passes each notification on
+to the WatchNotifications calls.  Publishing never waits for a call; a call that reads
+too slowly misses notifications and is told how many.
+*/
+type notificationFeed struct {
+	mutex       sync.Mutex
+	sequence    int64
+	subscribers map[*notificationSubscriber]struct{}
+}
+
+type notificationSubscriber struct {
+	dropped       int64 // Guarded by notificationFeed.mutex.
+	notifications chan *szadminpb.Notification
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Notifications held for a WatchNotifications call before later ones are dropped.
+const notificationBufferSize = 1024
+
+// ----------------------------------------------------------------------------
+// Interface methods for github.com/senzing-garage/go-observing/observer.Observer
+// ----------------------------------------------------------------------------
+
+func (feed *notificationFeed) GetObserverID(ctx context.Context) string {
+	_ = ctx
+
+	return Prefix + "notifications"
+}
+
+func (feed *notificationFeed) UpdateObserver(ctx context.Context, message string) {
+	_ = ctx
+
+	feed.mutex.Lock()
+	defer feed.mutex.Unlock()
+
+	feed.sequence++
+	now := timestamppb.New(time.Now())
+
+	for subscriber := range feed.subscribers {
+		notification := &szadminpb.Notification{
+			Sequence: feed.sequence,
+			Message:  message,
+			Time:     now,
+			Dropped:  subscriber.dropped,
+		}
+
+		select {
+		case subscriber.notifications <- notification:
+			subscriber.dropped = 0
+		default:
+			subscriber.dropped++
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods for github.com/senzing-garage/serve-grpc/proto/go/szadmin.SzAdminServer
+// ----------------------------------------------------------------------------
+
+func (server *adminServer) WatchNotifications(
+	request *szadminpb.WatchNotificationsRequest,
+	stream szadminpb.SzAdmin_WatchNotificationsServer,
+) error {
+	_ = request
+
+	feed := server.grpcServer.notificationFeed
+	if feed == nil {
+		return status.Error(codes.FailedPrecondition, "notifications are not enabled on this server")
+	}
+
+	subscriber := feed.subscribe()
+	defer feed.unsubscribe(subscriber)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return wraperror.Errorf(stream.Context().Err(), "stream.Context")
+		case notification := <-subscriber.notifications:
+			err := stream.Send(notification)
+			if err != nil {
+				return wraperror.Errorf(err, "stream.Send")
+			}
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Publish the notifications of the Senzing SDK, and of the redo processor, to WatchNotifications.
+func (grpcServer *BasicGrpcServer) enableNotifications() {
+	grpcServer.notificationFeed = &notificationFeed{subscribers: map[*notificationSubscriber]struct{}{}}
+	grpcServer.internalObservers = []observer.Observer{grpcServer.notificationFeed}
+}
+
+func (feed *notificationFeed) subscribe() *notificationSubscriber {
+	result := &notificationSubscriber{notifications: make(chan *szadminpb.Notification, notificationBufferSize)}
+
+	feed.mutex.Lock()
+	feed.subscribers[result] = struct{}{}
+	feed.mutex.Unlock()
+
+	return result
+}
+
+func (feed *notificationFeed) unsubscribe(subscriber *notificationSubscriber) {
+	feed.mutex.Lock()
+	delete(feed.subscribers, subscriber)
+	feed.mutex.Unlock()
+}
@@ -0,0 +1,104 @@
+package grpcserver_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/settings"
+	"github.com/senzing-garage/serve-grpc/grpcserver"
+	szadminpb "github.com/senzing-garage/serve-grpc/proto/go/szadmin"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestGrpcServerImpl_WatchNotifications(test *testing.T) {
+	ctx := test.Context()
+	grpcServer := getNotificationsTestObject(ctx, test, true)
+	adminClient := szadminpb.NewSzAdminClient(serveBufconn(test, grpcServer))
+
+	stream, err := adminClient.WatchNotifications(ctx, &szadminpb.WatchNotificationsRequest{})
+	require.NoError(test, err)
+
+	// The feed is not one of the caller's observers.
+
+	require.Empty(test, grpcServer.Observers)
+
+	// Pause and resume redo processing, which notifies observers, until the call has subscribed.
+
+	publishCtx, stopPublishing := context.WithCancel(ctx)
+
+	defer stopPublishing()
+
+	go func() {
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-publishCtx.Done():
+				return
+			case <-ticker.C:
+				_, _ = adminClient.PauseRedo(publishCtx, &szadminpb.PauseRedoRequest{})
+				_, _ = adminClient.ResumeRedo(publishCtx, &szadminpb.ResumeRedoRequest{})
+			}
+		}
+	}()
+
+	first, err := stream.Recv()
+	require.NoError(test, err)
+	require.Contains(test, first.GetMessage(), `"messageId":"201`)
+	require.NotNil(test, first.GetTime())
+
+	second, err := stream.Recv()
+	require.NoError(test, err)
+	require.Greater(test, second.GetSequence(), first.GetSequence())
+}
+
+func TestGrpcServerImpl_WatchNotifications_disabled(test *testing.T) {
+	ctx := test.Context()
+	grpcServer := getNotificationsTestObject(ctx, test, false)
+	adminClient := szadminpb.NewSzAdminClient(serveBufconn(test, grpcServer))
+
+	stream, err := adminClient.WatchNotifications(ctx, &szadminpb.WatchNotificationsRequest{})
+	require.NoError(test, err)
+
+	_, err = stream.Recv()
+	require.Equal(test, codes.FailedPrecondition, status.Code(err))
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func getNotificationsTestObject(
+	ctx context.Context,
+	test *testing.T,
+	enableNotifications bool,
+) *grpcserver.BasicGrpcServer {
+	test.Helper()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	result := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableNotifications: enableNotifications,
+		EnableSzEngine:      true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		RedoProcessing:      &grpcserver.RedoProcessing{Workers: 1},
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = result.Initialize(ctx)
+	require.NoError(test, err)
+
+	return result
+}
@@ -0,0 +1,557 @@
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/serve-grpc/proto/go/szbatch"
+	szjobpb "github.com/senzing-garage/serve-grpc/proto/go/szjob"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+A RateLimit bounds the calls one client makes to one class of methods.
+A zero RequestsPerSecond or MaxInFlight means that bound is not enforced.
+*/
+type RateLimit struct {
+	Burst             int     `json:"burst"`             // Token bucket size; defaults to RequestsPerSecond rounded up.
+	MaxInFlight       int     `json:"maxInFlight"`       // Calls allowed to run at the same time.
+	RequestsPerSecond float64 `json:"requestsPerSecond"` // Token bucket refill rate.
+}
+
+/*
+RateLimits holds the RateLimit of each method class.  It is read from a JSON file such as
+
+	{
+	  "analytics": {"requestsPerSecond": 1, "burst": 2, "maxInFlight": 2},
+	  "read": {"requestsPerSecond": 100, "maxInFlight": 20},
+	  "write": {"requestsPerSecond": 500, "burst": 1000}
+	}
+
+Clients are told apart by authenticated principal, else by the common name of their
+client certificate, else by IP address.  Methods in no class are not limited.  SzBulk
+streams take a token for each message they receive, waiting for the bucket to refill.
+SzBatch calls take a token for each item, so a batch larger than the burst of its
+class is refused.
+*/
+type RateLimits struct {
+	Analytics RateLimit `json:"analytics"` // FindNetwork*, exports, file exports and CheckRepositoryPerformance.
+	Read      RateLimit `json:"read"`      // Other szengine Get*, Search*, Find*, Why* and How* calls.
+	Write     RateLimit `json:"write"`     // Calls refused in read-only mode, such as AddRecord and DeleteRecord.
+}
+
+type methodClass string
+
+// clientQuota is the state of one client for one method class.
+type clientQuota struct {
+	inFlight int
+	limiter  *rate.Limiter
+}
+
+type clientQuotaKey struct {
+	class  methodClass
+	client string
+}
+
+// A rateLimitedServerStream takes a token for each message received.
+type rateLimitedServerStream struct {
+	grpc.ServerStream
+	fullMethod string
+	grpcServer *BasicGrpcServer
+	key        clientQuotaKey
+	refusal    error // The status that ended the stream, which handlers may wrap beyond recognition.
+}
+
+// rateLimiter enforces RateLimits.
+type rateLimiter struct {
+	lastSweep time.Time
+	limits    map[methodClass]RateLimit
+	mutex     sync.Mutex
+	quotas    map[clientQuotaKey]*clientQuota
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	methodClassAnalytics methodClass = "analytics"
+	methodClassRead      methodClass = "read"
+	methodClassWrite     methodClass = "write"
+)
+
+const (
+	inFlightRetryAfter    = time.Second
+	rateLimitSweepPeriod  = time.Minute
+	retryAfterMetadataKey = "retry-after"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Method patterns (path.Match syntax) of streams charged per message received, rather than per call.
+var perMessageMethodPatterns = []string{
+	"/szbulk.SzBulk/*",
+}
+
+// Method patterns (path.Match syntax) of each class, checked after the write class.
+var methodClassPatterns = []struct {
+	class    methodClass
+	patterns []string
+}{
+	{methodClassAnalytics, []string{
+		"/szadmin.SzAdmin/StartFileExport",
+		"/szdiagnostic.SzDiagnostic/CheckRepositoryPerformance",
+		"/szengine.SzEngine/Export*",
+		"/szengine.SzEngine/FindNetwork*",
+		"/szengine.SzEngine/StreamExport*",
+		"/szexport.SzExport/*",
+	}},
+	{methodClassRead, []string{
+		"/szbatch.SzBatch/*",
+		"/szengine.SzEngine/Find*",
+		"/szengine.SzEngine/Get*",
+		"/szengine.SzEngine/How*",
+		"/szengine.SzEngine/Search*",
+		"/szengine.SzEngine/Why*",
+	}},
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewRateLimits function reads RateLimits from a JSON file.
+
+Input
+  - filename: Path of the rate limits file.
+
+Output
+  - RateLimits.
+*/
+func NewRateLimits(filename string) (*RateLimits, error) {
+	result := &RateLimits{}
+
+	fileBytes, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "os.ReadFile %s", filename)
+	}
+
+	err = json.Unmarshal(fileBytes, result)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal %s", filename)
+	}
+
+	for class, limit := range result.byClass() {
+		if limit.Burst < 0 || limit.MaxInFlight < 0 || limit.RequestsPerSecond < 0 {
+			return nil, wraperror.Errorf(errForPackage, "%s: %s limits must not be negative", filename, class)
+		}
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods for grpc.ServerStream
+// ----------------------------------------------------------------------------
+
+func (stream *rateLimitedServerStream) RecvMsg(message any) error {
+	err := stream.ServerStream.RecvMsg(message)
+	if err != nil {
+		return err //nolint:wrapcheck // io.EOF must reach the handler unwrapped.
+	}
+
+	stream.refusal = stream.grpcServer.waitForToken(stream.Context(), stream.fullMethod, stream.key, stream.SetTrailer)
+
+	return stream.refusal
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// The size of the token bucket.
+func (limit RateLimit) burst() int {
+	if limit.Burst == 0 {
+		return int(math.Ceil(limit.RequestsPerSecond))
+	}
+
+	return limit.Burst
+}
+
+func (rateLimits *RateLimits) byClass() map[methodClass]RateLimit {
+	return map[methodClass]RateLimit{
+		methodClassAnalytics: rateLimits.Analytics,
+		methodClassRead:      rateLimits.Read,
+		methodClassWrite:     rateLimits.Write,
+	}
+}
+
+/*
+Take tokens and an in-flight slot for the client.
+If either is unavailable, return how long the client should wait instead.
+*/
+func (limiter *rateLimiter) acquire(key clientQuotaKey, now time.Time, tokens int) (time.Duration, bool) {
+	limit := limiter.limits[key.class]
+
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	limiter.sweep(now)
+
+	quota, isKnown := limiter.quotas[key]
+	if !isKnown {
+		quota = &clientQuota{}
+		if limit.RequestsPerSecond > 0 {
+			quota.limiter = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), limit.burst())
+		}
+
+		limiter.quotas[key] = quota
+	}
+
+	if limit.MaxInFlight > 0 && quota.inFlight >= limit.MaxInFlight {
+		return inFlightRetryAfter, false
+	}
+
+	if quota.limiter != nil && tokens > 0 {
+		reservation := quota.limiter.ReserveN(now, tokens)
+		if !reservation.OK() {
+			return inFlightRetryAfter, false
+		}
+
+		delay := reservation.DelayFrom(now)
+		if delay > 0 {
+			reservation.CancelAt(now)
+
+			return delay, false
+		}
+	}
+
+	quota.inFlight++
+
+	return 0, true
+}
+
+// Get the token bucket of a client holding an in-flight slot, or nil if its class has no rate.
+func (limiter *rateLimiter) tokenBucket(key clientQuotaKey) *rate.Limiter {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	if quota, isKnown := limiter.quotas[key]; isKnown {
+		return quota.limiter
+	}
+
+	return nil
+}
+
+func (limiter *rateLimiter) release(key clientQuotaKey) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	if quota, isKnown := limiter.quotas[key]; isKnown {
+		quota.inFlight--
+	}
+}
+
+// Forget idle clients whose token bucket is full again, so the map does not grow without bound.
+func (limiter *rateLimiter) sweep(now time.Time) {
+	if now.Sub(limiter.lastSweep) < rateLimitSweepPeriod {
+		return
+	}
+
+	limiter.lastSweep = now
+
+	for key, quota := range limiter.quotas {
+		if quota.inFlight > 0 {
+			continue
+		}
+
+		if quota.limiter == nil || quota.limiter.TokensAt(now) >= float64(quota.limiter.Burst()) {
+			delete(limiter.quotas, key)
+		}
+	}
+}
+
+/*
+Throttle unary calls, charging SzBatch calls a token for each item and SzJob.SubmitJob
+calls as the operation the job runs.
+*/
+func (grpcServer *BasicGrpcServer) rateLimitUnaryInterceptor(
+	ctx context.Context,
+	request any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	class, isClassified := classifyCall(info.FullMethod, request)
+
+	setTrailer := func(trailer metadata.MD) { _ = grpc.SetTrailer(ctx, trailer) }
+
+	release, err := grpcServer.acquireQuota(ctx, info.FullMethod, class, isClassified, requestTokens(request), setTrailer)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	return handler(ctx, request)
+}
+
+/*
+Throttle streams.  A stream holds its in-flight slot until it ends.  A stream charged
+per message takes no token to start, but one for each message it receives.
+*/
+func (grpcServer *BasicGrpcServer) rateLimitStreamInterceptor(
+	server any,
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	tokens := 1
+	isPerMessage := matchesAny(perMessageMethodPatterns, info.FullMethod)
+
+	if isPerMessage {
+		tokens = 0
+	}
+
+	class, isClassified := classifyMethod(info.FullMethod)
+
+	release, err := grpcServer.acquireQuota(stream.Context(), info.FullMethod, class, isClassified, tokens, stream.SetTrailer)
+	if err != nil {
+		return err
+	}
+
+	defer release()
+
+	if !isPerMessage || !isClassified || grpcServer.rateLimiter == nil {
+		return handler(server, stream)
+	}
+
+	rateLimitedStream := &rateLimitedServerStream{
+		ServerStream: stream,
+		fullMethod:   info.FullMethod,
+		grpcServer:   grpcServer,
+		key:          clientQuotaKey{class: class, client: clientKey(stream.Context())},
+	}
+
+	err = handler(server, rateLimitedStream)
+	if rateLimitedStream.refusal != nil {
+		return rateLimitedStream.refusal
+	}
+
+	return err
+}
+
+/*
+Apply the RateLimits of the call's class to the caller, charging tokens.  Calls
+costing more tokens than the bucket holds can never succeed, so they are refused as
+invalid rather than told to retry.  On success, the returned function gives back the
+in-flight slot.
+*/
+func (grpcServer *BasicGrpcServer) acquireQuota(
+	ctx context.Context,
+	fullMethod string,
+	class methodClass,
+	isClassified bool,
+	tokens int,
+	setTrailer func(metadata.MD),
+) (func(), error) {
+	noRelease := func() {}
+
+	if grpcServer.rateLimiter == nil || !isClassified {
+		return noRelease, nil
+	}
+
+	limit := grpcServer.rateLimiter.limits[class]
+	if limit.RequestsPerSecond > 0 && tokens > limit.burst() {
+		return noRelease, status.Errorf(
+			codes.InvalidArgument,
+			"%s: the call costs %d tokens, more than the %s rate limit burst of %d; split it into smaller calls",
+			fullMethod,
+			tokens,
+			class,
+			limit.burst(),
+		)
+	}
+
+	key := clientQuotaKey{class: class, client: clientKey(ctx)}
+
+	retryAfter, isAcquired := grpcServer.rateLimiter.acquire(key, time.Now(), tokens)
+	if !isAcquired {
+		grpcServer.log(1003, fullMethod, key.client, class, retryAfter)
+		setTrailer(metadata.Pairs(retryAfterMetadataKey, strconv.Itoa(int(math.Ceil(retryAfter.Seconds())))))
+
+		return noRelease, resourceExhaustedError(fullMethod, class, retryAfter)
+	}
+
+	return func() { grpcServer.rateLimiter.release(key) }, nil
+}
+
+// Take a token for a message of a stream holding an in-flight slot, waiting for the bucket to refill.
+func (grpcServer *BasicGrpcServer) waitForToken(
+	ctx context.Context,
+	fullMethod string,
+	key clientQuotaKey,
+	setTrailer func(metadata.MD),
+) error {
+	tokenBucket := grpcServer.rateLimiter.tokenBucket(key)
+	if tokenBucket == nil {
+		return nil
+	}
+
+	err := tokenBucket.Wait(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+
+		// Waiting would outlast the deadline of the call.
+
+		now := time.Now()
+		reservation := tokenBucket.ReserveN(now, 1)
+		retryAfter := reservation.DelayFrom(now)
+		reservation.CancelAt(now)
+
+		grpcServer.log(1003, fullMethod, key.client, key.class, retryAfter)
+		setTrailer(metadata.Pairs(retryAfterMetadataKey, strconv.Itoa(int(math.Ceil(retryAfter.Seconds())))))
+
+		return resourceExhaustedError(fullMethod, key.class, retryAfter)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func newRateLimiter(rateLimits *RateLimits) *rateLimiter {
+	return &rateLimiter{
+		limits: rateLimits.byClass(),
+		quotas: map[clientQuotaKey]*clientQuota{},
+	}
+}
+
+/*
+Classify a unary call.  A job is charged as the operation it runs: purges as writes and
+the other, long-running, operations as analytics.
+*/
+func classifyCall(fullMethod string, request any) (methodClass, bool) {
+	submitJob, isSubmitJob := request.(*szjobpb.SubmitJobRequest)
+	if !isSubmitJob {
+		return classifyMethod(fullMethod)
+	}
+
+	switch submitJob.GetOperation().(type) {
+	case nil:
+		return "", false
+	case *szjobpb.SubmitJobRequest_PurgeRepository:
+		return methodClassWrite, true
+	default:
+		return methodClassAnalytics, true
+	}
+}
+
+func classifyMethod(fullMethod string) (methodClass, bool) {
+	if mutatingMethods[fullMethod] {
+		return methodClassWrite, true
+	}
+
+	for _, classPatterns := range methodClassPatterns {
+		if matchesAny(classPatterns.patterns, fullMethod) {
+			return classPatterns.class, true
+		}
+	}
+
+	return "", false
+}
+
+// Identify the caller by authenticated principal, client certificate common name or IP address.
+func clientKey(ctx context.Context) string {
+	if principal, isAuthenticated := PrincipalFromContext(ctx); isAuthenticated {
+		return "principal:" + principal.Name
+	}
+
+	callerPeer, isPeer := peer.FromContext(ctx)
+	if !isPeer || callerPeer.Addr == nil {
+		return ""
+	}
+
+	tlsInfo, isTLS := callerPeer.AuthInfo.(credentials.TLSInfo)
+	if isTLS && len(tlsInfo.State.VerifiedChains) > 0 && len(tlsInfo.State.VerifiedChains[0]) > 0 {
+		return "cn:" + tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+	}
+
+	host, _, err := net.SplitHostPort(callerPeer.Addr.String())
+	if err != nil {
+		return "ip:" + callerPeer.Addr.String()
+	}
+
+	return "ip:" + host
+}
+
+func matchesAny(patterns []string, fullMethod string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		isMatch, err := path.Match(pattern, fullMethod)
+
+		return err == nil && isMatch
+	})
+}
+
+// The tokens a unary call costs: one for each item of a SzBatch request, else one.
+func requestTokens(request any) int {
+	var items int
+
+	switch batch := request.(type) {
+	case *szbatch.GetEntitiesRequest:
+		items = len(batch.GetEntityIds())
+	case *szbatch.GetRecordsRequest:
+		items = len(batch.GetRecordKeys())
+	case *szbatch.SearchByAttributesRequest:
+		items = len(batch.GetAttributes())
+	default:
+		return 1
+	}
+
+	return max(items, 1)
+}
+
+func resourceExhaustedError(fullMethod string, class methodClass, retryAfter time.Duration) error {
+	result := status.Newf(codes.ResourceExhausted, "%s: %s rate limit exceeded; retry after %s", fullMethod, class, retryAfter)
+
+	resultWithDetails, err := result.WithDetails(
+		&errdetails.ErrorInfo{
+			Domain:   ErrorInfoDomain,
+			Reason:   "RATE_LIMITED",
+			Metadata: map[string]string{"class": string(class)},
+		},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)},
+	)
+	if err != nil {
+		return result.Err()
+	}
+
+	return resultWithDetails.Err()
+}
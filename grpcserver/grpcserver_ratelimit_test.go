@@ -0,0 +1,227 @@
+package grpcserver_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/settings"
+	"github.com/senzing-garage/serve-grpc/grpcserver"
+	szbatchpb "github.com/senzing-garage/serve-grpc/proto/go/szbatch"
+	szbulkpb "github.com/senzing-garage/serve-grpc/proto/go/szbulk"
+	szjobpb "github.com/senzing-garage/serve-grpc/proto/go/szjob"
+	szenginepb "github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestNewRateLimits(test *testing.T) {
+	rateLimits, err := grpcserver.NewRateLimits(writeTestFile(test, "rate-limits.json", `{
+		"analytics": {"requestsPerSecond": 1, "burst": 2, "maxInFlight": 2},
+		"read": {"requestsPerSecond": 100}
+	}`))
+	require.NoError(test, err)
+	require.Equal(test, grpcserver.RateLimit{Burst: 2, MaxInFlight: 2, RequestsPerSecond: 1}, rateLimits.Analytics)
+	require.InDelta(test, 100.0, rateLimits.Read.RequestsPerSecond, 0)
+	require.Equal(test, grpcserver.RateLimit{}, rateLimits.Write)
+}
+
+func TestNewRateLimits_badFile(test *testing.T) {
+	_, err := grpcserver.NewRateLimits(filepath.Join(test.TempDir(), "missing.json"))
+	require.Error(test, err)
+
+	_, err = grpcserver.NewRateLimits(writeTestFile(test, "rate-limits.json", `{"read": `))
+	require.Error(test, err)
+
+	_, err = grpcserver.NewRateLimits(writeTestFile(test, "rate-limits.json", `{"read": {"maxInFlight": -1}}`))
+	require.Error(test, err)
+}
+
+func TestGrpcServerImpl_RateLimits(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableSzEngine:      true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		RateLimits:          &grpcserver.RateLimits{Read: grpcserver.RateLimit{Burst: 1, RequestsPerSecond: 0.01}},
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	engineClient := szenginepb.NewSzEngineClient(serveBufconn(test, grpcServer))
+	request := &szenginepb.GetRecordRequest{DataSourceCode: "CUSTOMERS", RecordId: "1"}
+
+	_, err = engineClient.GetRecord(ctx, request)
+	require.NotEqual(test, codes.ResourceExhausted, status.Code(err))
+
+	var trailer metadata.MD
+
+	_, err = engineClient.GetRecord(ctx, request, grpc.Trailer(&trailer))
+	require.Equal(test, codes.ResourceExhausted, status.Code(err))
+	require.NotEmpty(test, trailer.Get("retry-after"))
+
+	var retryInfo *errdetails.RetryInfo
+
+	for _, detail := range status.Convert(err).Details() {
+		if detail, isRetryInfo := detail.(*errdetails.RetryInfo); isRetryInfo {
+			retryInfo = detail
+		}
+	}
+
+	require.NotNil(test, retryInfo)
+	require.Positive(test, retryInfo.GetRetryDelay().AsDuration())
+
+	// Other method classes have their own buckets.
+
+	_, err = engineClient.AddRecord(ctx, &szenginepb.AddRecordRequest{DataSourceCode: "CUSTOMERS", RecordId: "1"})
+	require.NotEqual(test, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestGrpcServerImpl_RateLimits_bulk(test *testing.T) {
+	ctx, cancel := context.WithTimeout(test.Context(), 10*time.Second)
+	defer cancel()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableSzEngine:      true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		RateLimits:          &grpcserver.RateLimits{Write: grpcserver.RateLimit{Burst: 1, RequestsPerSecond: 0.01}},
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	// Each record takes a write token, so the second one outlasts the call's deadline.
+
+	stream, err := szbulkpb.NewSzBulkClient(serveBufconn(test, grpcServer)).AddRecords(ctx)
+	require.NoError(test, err)
+
+	for _, recordID := range []string{"RATE-1", "RATE-2"} {
+		require.NoError(test, stream.Send(&szenginepb.AddRecordRequest{DataSourceCode: "CUSTOMERS", RecordId: recordID}))
+	}
+
+	require.NoError(test, stream.CloseSend())
+
+	responses := 0
+
+	for {
+		_, err = stream.Recv()
+		if err != nil {
+			break
+		}
+
+		responses++
+	}
+
+	require.Equal(test, codes.ResourceExhausted, status.Code(err))
+	require.LessOrEqual(test, responses, 1)
+	require.NotEmpty(test, stream.Trailer().Get("retry-after"))
+}
+
+func TestGrpcServerImpl_RateLimits_batch(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableSzEngine:      true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		RateLimits:          &grpcserver.RateLimits{Read: grpcserver.RateLimit{Burst: 3, RequestsPerSecond: 0.01}},
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	batchClient := szbatchpb.NewSzBatchClient(serveBufconn(test, grpcServer))
+
+	// A batch larger than the bucket could never run.
+
+	_, err = batchClient.GetEntities(ctx, &szbatchpb.GetEntitiesRequest{EntityIds: []int64{1, 2, 3, 4}})
+	require.Equal(test, codes.InvalidArgument, status.Code(err))
+
+	// Each item takes a token, so a full batch empties the bucket.
+
+	_, err = batchClient.GetEntities(ctx, &szbatchpb.GetEntitiesRequest{EntityIds: []int64{1, 2, 3}})
+	require.NotEqual(test, codes.ResourceExhausted, status.Code(err))
+
+	_, err = batchClient.GetEntities(ctx, &szbatchpb.GetEntitiesRequest{EntityIds: []int64{1}})
+	require.Equal(test, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestGrpcServerImpl_RateLimits_jobs(test *testing.T) {
+	ctx, cancel := context.WithTimeout(test.Context(), 10*time.Second)
+	defer cancel()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:       true,
+		EnableSzDiagnostic: true,
+		EnableSzEngine:     true,
+		LogLevelName:       "INFO",
+		Port:               8258,
+		RateLimits: &grpcserver.RateLimits{
+			Analytics: grpcserver.RateLimit{Burst: 1, RequestsPerSecond: 0.01},
+			Write:     grpcserver.RateLimit{Burst: 1, RequestsPerSecond: 0.01},
+		},
+		ReadOnly:            true,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	jobClient := szjobpb.NewSzJobClient(serveBufconn(test, grpcServer))
+	primeEngine := &szjobpb.SubmitJobRequest{
+		Operation: &szjobpb.SubmitJobRequest_PrimeEngine{PrimeEngine: &szjobpb.PrimeEngine{}},
+	}
+	purgeRepository := &szjobpb.SubmitJobRequest{
+		Operation: &szjobpb.SubmitJobRequest_PurgeRepository{PurgeRepository: &szjobpb.PurgeRepository{}},
+	}
+
+	// Priming takes an analytics token.
+
+	_, err = jobClient.SubmitJob(ctx, primeEngine)
+	require.NoError(test, err)
+
+	_, err = jobClient.SubmitJob(ctx, primeEngine)
+	require.Equal(test, codes.ResourceExhausted, status.Code(err))
+
+	// Purging takes a write token, even when the read-only server then refuses it.
+
+	_, err = jobClient.SubmitJob(ctx, purgeRepository)
+	require.Equal(test, codes.FailedPrecondition, status.Code(err))
+
+	_, err = jobClient.SubmitJob(ctx, purgeRepository)
+	require.Equal(test, codes.ResourceExhausted, status.Code(err))
+}
@@ -0,0 +1,181 @@
+package grpcserver
+
+import (
+	"context"
+	"slices"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/serve-grpc/proto/go/szbulk"
+	"github.com/senzing-garage/sz-sdk-proto/go/szconfigmanager"
+	"github.com/senzing-garage/sz-sdk-proto/go/szdiagnostic"
+	"github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionalphapb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+/*
+ReadOnlyServiceName is the grpc.health.v1.Health service name that reports SERVING
+when BasicGrpcServer.ReadOnly is set.  Otherwise Check returns NOT_FOUND for it.
+*/
+const ReadOnlyServiceName = "serve-grpc.ReadOnly"
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+/*
+Methods that change the Senzing repository or its configuration.
+szconfig methods only transform the config JSON sent with the request, so they are not listed.
+*/
+var mutatingMethods = map[string]bool{
+	szbulk.SzBulk_AddRecords_FullMethodName:                               true,
+	szbulk.SzBulk_DeleteRecords_FullMethodName:                            true,
+	szbulk.SzBulk_LoadRecords_FullMethodName:                              true,
+	szbulk.SzBulk_ReevaluateEntities_FullMethodName:                       true,
+	szbulk.SzBulk_ReevaluateRecords_FullMethodName:                        true,
+	szconfigmanager.SzConfigManager_RegisterConfig_FullMethodName:         true,
+	szconfigmanager.SzConfigManager_ReplaceDefaultConfigId_FullMethodName: true,
+	szconfigmanager.SzConfigManager_SetDefaultConfig_FullMethodName:       true,
+	szconfigmanager.SzConfigManager_SetDefaultConfigId_FullMethodName:     true,
+	szdiagnostic.SzDiagnostic_PurgeRepository_FullMethodName:              true,
+	szengine.SzEngine_AddRecord_FullMethodName:                            true,
+	szengine.SzEngine_DeleteRecord_FullMethodName:                         true,
+	szengine.SzEngine_ProcessRedoRecord_FullMethodName:                    true,
+	szengine.SzEngine_ReevaluateEntity_FullMethodName:                     true,
+	szengine.SzEngine_ReevaluateRecord_FullMethodName:                     true,
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Refuse mutating unary calls when ReadOnly is set.
+func (grpcServer *BasicGrpcServer) readOnlyUnaryInterceptor(
+	ctx context.Context,
+	request any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	if grpcServer.ReadOnly && mutatingMethods[info.FullMethod] {
+		return nil, readOnlyError(info.FullMethod)
+	}
+
+	return handler(ctx, request)
+}
+
+// Refuse mutating streams when ReadOnly is set.
+func (grpcServer *BasicGrpcServer) readOnlyStreamInterceptor(
+	server any,
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if grpcServer.ReadOnly && mutatingMethods[info.FullMethod] {
+		return readOnlyError(info.FullMethod)
+	}
+
+	return handler(server, stream)
+}
+
+// Advertise read-only mode in grpc.health.v1.Health.
+func (grpcServer *BasicGrpcServer) enableReadOnlyHealth() {
+	grpcServer.setHealthStatus(ReadOnlyServiceName, healthpb.HealthCheckResponse_SERVING, nil)
+}
+
+/*
+Register server reflection.  In read-only mode the service descriptors it serves
+omit the mutating methods, so tools like grpcurl only offer the calls that work.
+*/
+func (grpcServer *BasicGrpcServer) enableReflection() error {
+	if !grpcServer.ReadOnly {
+		reflection.Register(grpcServer.grpcserver)
+
+		return nil
+	}
+
+	descriptorResolver, err := readOnlyDescriptors()
+	if err != nil {
+		return wraperror.Errorf(err, "readOnlyDescriptors")
+	}
+
+	reflectionOptions := reflection.ServerOptions{
+		Services:           grpcServer.grpcserver,
+		DescriptorResolver: descriptorResolver,
+	}
+	reflectionpb.RegisterServerReflectionServer(grpcServer.grpcserver, reflection.NewServerV1(reflectionOptions))
+	reflectionalphapb.RegisterServerReflectionServer(grpcServer.grpcserver, reflection.NewServer(reflectionOptions))
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// A copy of protoregistry.GlobalFiles without the mutating methods.
+func readOnlyDescriptors() (*protoregistry.Files, error) {
+	var err error
+
+	result := &protoregistry.Files{}
+
+	protoregistry.GlobalFiles.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		file, err = withoutMutatingMethods(file)
+		if err == nil {
+			err = result.RegisterFile(file)
+		}
+
+		return err == nil
+	})
+
+	return result, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+func readOnlyError(fullMethod string) error {
+	result := status.New(codes.FailedPrecondition, fullMethod+" is not allowed: the server is read-only")
+
+	resultWithDetails, err := result.WithDetails(&errdetails.ErrorInfo{
+		Domain: ErrorInfoDomain,
+		Reason: "READ_ONLY",
+	})
+	if err != nil {
+		return result.Err()
+	}
+
+	return resultWithDetails.Err()
+}
+
+func withoutMutatingMethods(file protoreflect.FileDescriptor) (protoreflect.FileDescriptor, error) {
+	isChanged := false
+	fileProto := protodesc.ToFileDescriptorProto(file)
+
+	for _, service := range fileProto.GetService() {
+		serviceName := "/" + fileProto.GetPackage() + "." + service.GetName() + "/"
+		methodCount := len(service.GetMethod())
+		service.Method = slices.DeleteFunc(service.GetMethod(), func(method *descriptorpb.MethodDescriptorProto) bool {
+			return mutatingMethods[serviceName+method.GetName()]
+		})
+		isChanged = isChanged || len(service.GetMethod()) != methodCount
+	}
+
+	if !isChanged {
+		return file, nil
+	}
+
+	result, err := protodesc.NewFile(fileProto, protoregistry.GlobalFiles)
+
+	return result, wraperror.Errorf(err, "protodesc.NewFile %s", file.Path())
+}
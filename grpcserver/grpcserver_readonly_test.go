@@ -0,0 +1,111 @@
+package grpcserver_test
+
+import (
+	"testing"
+
+	"github.com/senzing-garage/go-helpers/settings"
+	"github.com/senzing-garage/serve-grpc/grpcserver"
+	szenginepb "github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestGrpcServerImpl_ReadOnly(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableSzEngine:      true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		ReadOnly:            true,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	clientConn := serveBufconn(test, grpcServer)
+	engineClient := szenginepb.NewSzEngineClient(clientConn)
+
+	_, err = engineClient.AddRecord(ctx, &szenginepb.AddRecordRequest{DataSourceCode: "CUSTOMERS", RecordId: "1"})
+	require.Equal(test, codes.FailedPrecondition, status.Code(err))
+
+	_, err = engineClient.GetRecord(ctx, &szenginepb.GetRecordRequest{DataSourceCode: "CUSTOMERS", RecordId: "1"})
+	require.NotEqual(test, codes.FailedPrecondition, status.Code(err))
+
+	// Health advertises the mode.
+
+	healthResponse, err := healthpb.NewHealthClient(clientConn).Check(
+		ctx,
+		&healthpb.HealthCheckRequest{Service: grpcserver.ReadOnlyServiceName},
+	)
+	require.NoError(test, err)
+	require.Equal(test, healthpb.HealthCheckResponse_SERVING, healthResponse.GetStatus())
+
+	// Reflection omits the mutating methods.
+
+	reflectionStream, err := reflectionpb.NewServerReflectionClient(clientConn).ServerReflectionInfo(ctx)
+	require.NoError(test, err)
+	require.NoError(test, reflectionStream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_FileContainingSymbol{
+			FileContainingSymbol: szenginepb.SzEngine_ServiceDesc.ServiceName,
+		},
+	}))
+	reflectionResponse, err := reflectionStream.Recv()
+	require.NoError(test, err)
+
+	fileDescriptorProto := &descriptorpb.FileDescriptorProto{}
+	require.NoError(test, proto.Unmarshal(
+		reflectionResponse.GetFileDescriptorResponse().GetFileDescriptorProto()[0],
+		fileDescriptorProto,
+	))
+
+	var methodNames []string
+	for _, method := range fileDescriptorProto.GetService()[0].GetMethod() {
+		methodNames = append(methodNames, method.GetName())
+	}
+
+	require.Contains(test, methodNames, "GetRecord")
+	require.NotContains(test, methodNames, "AddRecord")
+}
+
+func TestGrpcServerImpl_ReadOnly_notSet(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableSzEngine:      true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	clientConn := serveBufconn(test, grpcServer)
+
+	_, err = healthpb.NewHealthClient(clientConn).Check(
+		ctx,
+		&healthpb.HealthCheckRequest{Service: grpcserver.ReadOnlyServiceName},
+	)
+	require.Equal(test, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,395 @@
+package grpcserver
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/go-observing/notifier"
+	"github.com/senzing-garage/go-observing/subject"
+	szadminpb "github.com/senzing-garage/serve-grpc/proto/go/szadmin"
+	"github.com/senzing-garage/serve-grpc/szengineserver"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"golang.org/x/time/rate"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+RedoProcessing configures the background redo processor, which drains the Senzing
+redo queue with Workers goroutines so that no separate redo service is needed.
+
+When the queue is empty a worker waits IdleBackoff, doubling the wait each time the
+queue is still empty up to MaxIdleBackoff.  MaxPerSecond caps the redo records
+processed per second by all workers together; zero means no cap.
+*/
+type RedoProcessing struct {
+	IdleBackoff    time.Duration
+	MaxIdleBackoff time.Duration
+	MaxPerSecond   float64
+	Workers        int
+}
+
+// redoProcessor is the running state of RedoProcessing.
+type redoProcessor struct {
+	busyWorkers    atomic.Int64
+	config         RedoProcessing
+	failed         atomic.Int64
+	lastError      atomic.Value
+	limiter        *rate.Limiter
+	metrics        *redoMetrics
+	mutex          sync.Mutex
+	observers      subject.Subject
+	observerOrigin string
+	paused         bool
+	processed      atomic.Int64
+	resumed        chan struct{}
+	stop           context.CancelFunc
+	workersExited  sync.WaitGroup
+}
+
+// redoMetrics holds the Prometheus collectors updated by the redo processor.
+type redoMetrics struct {
+	busyWorkers prometheus.Gauge
+	paused      prometheus.Gauge
+	records     *prometheus.CounterVec
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Default wait of an idle redo worker.
+const DefaultRedoIdleBackoff = time.Second
+
+// Default longest wait of an idle redo worker.
+const DefaultRedoMaxIdleBackoff = 30 * time.Second
+
+// Redo records between progress notifications to observers.
+const redoProgressInterval = 1000
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Create the redo processor.  Its workers do not run until startRedoProcessor is called.
+func (grpcServer *BasicGrpcServer) newRedoProcessor(ctx context.Context) *redoProcessor {
+	result := &redoProcessor{
+		config:         *grpcServer.RedoProcessing,
+		metrics:        newRedoMetrics(grpcServer.metrics.registry),
+		observerOrigin: grpcServer.ObserverOrigin,
+		resumed:        make(chan struct{}),
+	}
+
+	close(result.resumed)
+	result.lastError.Store("")
+
+	if result.config.IdleBackoff <= 0 {
+		result.config.IdleBackoff = DefaultRedoIdleBackoff
+	}
+
+	if result.config.MaxIdleBackoff < result.config.IdleBackoff {
+		result.config.MaxIdleBackoff = max(DefaultRedoMaxIdleBackoff, result.config.IdleBackoff)
+	}
+
+	if result.config.MaxPerSecond > 0 {
+		result.limiter = rate.NewLimiter(rate.Limit(result.config.MaxPerSecond), 1)
+	}
+
+	if allObservers := grpcServer.getObservers(); len(allObservers) > 0 {
+		observers := subject.NewSimpleSubject()
+
+		for _, observer := range allObservers {
+			err := observers.RegisterObserver(ctx, observer)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		result.observers = observers
+	}
+
+	return result
+}
+
+// Start the redo workers.  They run until stopRedoProcessor is called.
+func (grpcServer *BasicGrpcServer) startRedoProcessor(ctx context.Context) {
+	processor := grpcServer.redoProcessor
+	if processor == nil {
+		return
+	}
+
+	redoCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	processor.stop = cancel
+
+	grpcServer.log(2009, processor.config.Workers)
+	processor.notify(redoCtx, 2009, nil)
+
+	for range processor.config.Workers {
+		processor.workersExited.Go(func() { grpcServer.processRedo(redoCtx) })
+	}
+}
+
+// Stop the redo workers, letting each finish the redo record it is processing until ctx is done.
+func (grpcServer *BasicGrpcServer) stopRedoProcessor(ctx context.Context) {
+	processor := grpcServer.redoProcessor
+	if processor == nil || processor.stop == nil {
+		return
+	}
+
+	processor.stop()
+	processor.stop = nil
+
+	workersExited := make(chan struct{})
+
+	go func() {
+		processor.workersExited.Wait()
+		close(workersExited)
+	}()
+
+	select {
+	case <-workersExited:
+	case <-ctx.Done():
+		grpcServer.log(3009, context.Cause(ctx))
+	}
+
+	grpcServer.log(2012, processor.processed.Load(), processor.failed.Load())
+	processor.notify(ctx, 2012, nil)
+}
+
+// The loop of one redo worker.
+func (grpcServer *BasicGrpcServer) processRedo(ctx context.Context) {
+	processor := grpcServer.redoProcessor
+	idleBackoff := processor.config.IdleBackoff
+
+	for {
+		err := processor.waitToProcess(ctx)
+		if err != nil {
+			return
+		}
+
+		isProcessed, err := processor.processOne(ctx)
+		if err != nil {
+			grpcServer.log(3005, err)
+		}
+
+		if isProcessed {
+			idleBackoff = processor.config.IdleBackoff
+
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(idleBackoff):
+		}
+
+		idleBackoff = min(2*idleBackoff, processor.config.MaxIdleBackoff)
+	}
+}
+
+// Wait until the processor is resumed and the rate limit allows another redo record.
+func (processor *redoProcessor) waitToProcess(ctx context.Context) error {
+	for {
+		processor.mutex.Lock()
+		resumed := processor.resumed
+		processor.mutex.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return wraperror.Errorf(ctx.Err(), wraperror.NoMessage)
+		case <-resumed:
+		}
+
+		if processor.limiter != nil {
+			err := processor.limiter.Wait(ctx)
+			if err != nil {
+				return wraperror.Errorf(err, "limiter.Wait")
+			}
+		}
+
+		// Paused while waiting for the limiter?
+
+		processor.mutex.Lock()
+		paused := processor.paused
+		processor.mutex.Unlock()
+
+		if !paused {
+			return nil
+		}
+	}
+}
+
+/*
+Take one redo record from the queue and process it.  Once taken, a redo record is
+processed even if ctx is canceled, as it is no longer in the queue.
+
+Output
+  - False if the queue was empty or the record could not be taken.
+*/
+func (processor *redoProcessor) processOne(ctx context.Context) (bool, error) {
+	ctx = context.WithoutCancel(ctx)
+
+	processor.busyWorkers.Add(1)
+	processor.metrics.busyWorkers.Inc()
+
+	defer func() {
+		processor.busyWorkers.Add(-1)
+		processor.metrics.busyWorkers.Dec()
+	}()
+
+	redoRecord, err := szengineserver.GetSdkSzEngine().GetRedoRecord(ctx)
+	if err != nil {
+		processor.lastError.Store(err.Error())
+
+		return false, wraperror.Errorf(err, "GetRedoRecord")
+	}
+
+	if len(redoRecord) == 0 {
+		return false, nil
+	}
+
+	_, err = szengineserver.GetSdkSzEngine().ProcessRedoRecord(ctx, redoRecord, senzing.SzNoFlags)
+	if err != nil {
+		processor.failed.Add(1)
+		processor.metrics.records.WithLabelValues("failed").Inc()
+		processor.lastError.Store(err.Error())
+	} else {
+		processor.processed.Add(1)
+		processor.metrics.records.WithLabelValues("succeeded").Inc()
+	}
+
+	if (processor.processed.Load()+processor.failed.Load())%redoProgressInterval == 0 {
+		processor.notify(ctx, 1004, nil)
+	}
+
+	return true, wraperror.Errorf(err, "ProcessRedoRecord")
+}
+
+// Stop taking redo records from the queue.
+func (grpcServer *BasicGrpcServer) pauseRedo(ctx context.Context) {
+	processor := grpcServer.redoProcessor
+
+	processor.mutex.Lock()
+
+	if processor.paused {
+		processor.mutex.Unlock()
+
+		return
+	}
+
+	processor.paused = true
+	processor.resumed = make(chan struct{})
+	processor.metrics.paused.Set(1)
+
+	processor.mutex.Unlock()
+
+	grpcServer.log(2010)
+	processor.notify(ctx, 2010, nil)
+}
+
+// Start taking redo records from the queue again.
+func (grpcServer *BasicGrpcServer) resumeRedo(ctx context.Context) {
+	processor := grpcServer.redoProcessor
+
+	processor.mutex.Lock()
+
+	if !processor.paused {
+		processor.mutex.Unlock()
+
+		return
+	}
+
+	processor.paused = false
+	close(processor.resumed)
+	processor.metrics.paused.Set(0)
+
+	processor.mutex.Unlock()
+
+	grpcServer.log(2011)
+	processor.notify(ctx, 2011, nil)
+}
+
+// Report the state and counters of the redo processor.
+func (grpcServer *BasicGrpcServer) getRedoStatus(ctx context.Context) *szadminpb.RedoStatusResponse {
+	processor := grpcServer.redoProcessor
+	if processor == nil {
+		return &szadminpb.RedoStatusResponse{State: szadminpb.RedoState_REDO_STATE_DISABLED}
+	}
+
+	result := &szadminpb.RedoStatusResponse{
+		State:       szadminpb.RedoState_REDO_STATE_RUNNING,
+		Workers:     int32(processor.config.Workers), //nolint:gosec // Worker counts are small.
+		BusyWorkers: int32(processor.busyWorkers.Load()),
+		Processed:   processor.processed.Load(),
+		Failed:      processor.failed.Load(),
+		LastError:   processor.lastError.Load().(string), //nolint:forcetypeassert // Only strings are stored.
+		Queued:      -1,
+	}
+
+	processor.mutex.Lock()
+	if processor.paused {
+		result.State = szadminpb.RedoState_REDO_STATE_PAUSED
+	}
+	processor.mutex.Unlock()
+
+	queued, err := szengineserver.GetSdkSzEngine().CountRedoRecords(ctx)
+	if err == nil {
+		result.Queued = queued
+	}
+
+	return result
+}
+
+// Tell observers about the redo processor.
+func (processor *redoProcessor) notify(ctx context.Context, messageID int, err error) {
+	if processor.observers == nil {
+		return
+	}
+
+	processor.mutex.Lock()
+	paused := processor.paused
+	processor.mutex.Unlock()
+
+	notifier.Notify(ctx, processor.observers, processor.observerOrigin, ComponentID, messageID, err, map[string]string{
+		"failed":    strconv.FormatInt(processor.failed.Load(), 10),
+		"paused":    strconv.FormatBool(paused),
+		"processed": strconv.FormatInt(processor.processed.Load(), 10),
+		"workers":   strconv.Itoa(processor.config.Workers),
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func newRedoMetrics(registry *prometheus.Registry) *redoMetrics {
+	result := &redoMetrics{
+		busyWorkers: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "redo_workers_busy",
+			Help:      "Number of redo workers processing a redo record.",
+		}),
+		paused: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "redo_paused",
+			Help:      "1 if redo processing is paused, otherwise 0.",
+		}),
+		records: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "redo_records_total",
+			Help:      "Number of redo records processed, by outcome.",
+		}, []string{"outcome"}),
+	}
+
+	registry.MustRegister(result.busyWorkers, result.paused, result.records)
+
+	return result
+}
@@ -0,0 +1,220 @@
+package grpcserver_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/record"
+	"github.com/senzing-garage/go-helpers/settings"
+	"github.com/senzing-garage/go-helpers/truthset"
+	"github.com/senzing-garage/serve-grpc/grpcserver"
+	szadminpb "github.com/senzing-garage/serve-grpc/proto/go/szadmin"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	szenginepb "github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestGrpcServerImpl_RedoProcessing(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableSzEngine:      true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		RedoProcessing:      &grpcserver.RedoProcessing{Workers: 2},
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	adminClient := szadminpb.NewSzAdminClient(serveBufconn(test, grpcServer))
+
+	redoStatus, err := adminClient.GetRedoStatus(ctx, &szadminpb.GetRedoStatusRequest{})
+	require.NoError(test, err)
+	require.Equal(test, szadminpb.RedoState_REDO_STATE_RUNNING, redoStatus.GetState())
+	require.Equal(test, int32(2), redoStatus.GetWorkers())
+
+	redoStatus, err = adminClient.PauseRedo(ctx, &szadminpb.PauseRedoRequest{})
+	require.NoError(test, err)
+	require.Equal(test, szadminpb.RedoState_REDO_STATE_PAUSED, redoStatus.GetState())
+
+	redoStatus, err = adminClient.ResumeRedo(ctx, &szadminpb.ResumeRedoRequest{})
+	require.NoError(test, err)
+	require.Equal(test, szadminpb.RedoState_REDO_STATE_RUNNING, redoStatus.GetState())
+}
+
+func TestGrpcServerImpl_RedoProcessing_serving(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	redoProcessing := &grpcserver.RedoProcessing{
+		IdleBackoff:    5 * time.Millisecond,
+		MaxIdleBackoff: 20 * time.Millisecond,
+		MaxPerSecond:   20,
+		Workers:        2,
+	}
+	grpcServer := &grpcserver.BasicGrpcServer{
+		EnableSzEngine:      true,
+		LogLevelName:        "INFO",
+		RedoProcessing:      redoProcessing,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+		SinglePort:          true,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	clientConn := serveBufconn(test, grpcServer)
+	adminClient := szadminpb.NewSzAdminClient(clientConn)
+	engineClient := szenginepb.NewSzEngineClient(clientConn)
+
+	served := make(chan error, 1)
+
+	go func() { served <- grpcServer.Serve(ctx) }()
+
+	// Drain what earlier tests left, then let the idle workers back off to MaxIdleBackoff.
+
+	isDrained := func() bool { return getRedoStatus(ctx, test, adminClient).GetQueued() == 0 }
+
+	require.Eventually(test, isDrained, 10*time.Second, time.Millisecond)
+	time.Sleep(10 * redoProcessing.MaxIdleBackoff)
+
+	before := getRedoStatus(ctx, test, adminClient)
+	records := []record.Record{
+		truthset.CustomerRecords["1001"],
+		truthset.CustomerRecords["1002"],
+		truthset.CustomerRecords["1003"],
+		truthset.CustomerRecords["1004"],
+		truthset.CustomerRecords["1005"],
+		truthset.CustomerRecords["1009"],
+	}
+
+	for _, record := range records {
+		_, err = engineClient.AddRecord(ctx, &szenginepb.AddRecordRequest{
+			DataSourceCode:   record.DataSource,
+			RecordId:         record.ID,
+			RecordDefinition: record.JSON,
+			Flags:            senzing.SzWithoutInfo,
+		})
+		require.NoError(test, err)
+	}
+
+	queued := getRedoStatus(ctx, test, adminClient).GetQueued()
+	require.Positive(test, queued)
+
+	// The queue is drained, no faster than MaxPerSecond allows.
+
+	startTime := time.Now()
+
+	require.Eventually(test, isDrained, 10*time.Second, time.Millisecond)
+
+	after := getRedoStatus(ctx, test, adminClient)
+	completed := after.GetProcessed() + after.GetFailed() - before.GetProcessed() - before.GetFailed()
+	require.GreaterOrEqual(test, completed, queued)
+
+	minDuration := time.Duration(float64(queued-1) / redoProcessing.MaxPerSecond * float64(time.Second))
+	require.GreaterOrEqual(test, time.Since(startTime), minDuration-redoProcessing.MaxIdleBackoff)
+
+	// The metrics count the same redo records.
+
+	recorder := httptest.NewRecorder()
+	grpcServer.GetMetricsHandler().ServeHTTP(recorder, httptest.NewRequestWithContext(ctx, http.MethodGet, "/metrics", nil))
+	require.Contains(test, recorder.Body.String(), fmt.Sprintf(`serve_grpc_redo_records_total{outcome="succeeded"} %d`, after.GetProcessed()))
+
+	for _, record := range records {
+		_, err = engineClient.DeleteRecord(ctx, &szenginepb.DeleteRecordRequest{
+			DataSourceCode: record.DataSource,
+			RecordId:       record.ID,
+			Flags:          senzing.SzWithoutInfo,
+		})
+		require.NoError(test, err)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	err = grpcServer.Shutdown(shutdownCtx)
+	require.NoError(test, err)
+	require.NoError(test, <-served)
+}
+
+func TestGrpcServerImpl_RedoProcessing_disabled(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableSzEngine:      true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	adminClient := szadminpb.NewSzAdminClient(serveBufconn(test, grpcServer))
+
+	redoStatus, err := adminClient.GetRedoStatus(ctx, &szadminpb.GetRedoStatusRequest{})
+	require.NoError(test, err)
+	require.Equal(test, szadminpb.RedoState_REDO_STATE_DISABLED, redoStatus.GetState())
+
+	_, err = adminClient.PauseRedo(ctx, &szadminpb.PauseRedoRequest{})
+	require.Equal(test, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestGrpcServerImpl_RedoProcessing_readOnly(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableSzEngine:      true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		ReadOnly:            true,
+		RedoProcessing:      &grpcserver.RedoProcessing{Workers: 1},
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.Error(test, err)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func getRedoStatus(ctx context.Context, test *testing.T, adminClient szadminpb.SzAdminClient) *szadminpb.RedoStatusResponse {
+	test.Helper()
+
+	result, err := adminClient.GetRedoStatus(ctx, &szadminpb.GetRedoStatusRequest{})
+	require.NoError(test, err)
+
+	return result
+}
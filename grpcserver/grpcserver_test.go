@@ -2,8 +2,15 @@ package grpcserver_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -12,13 +19,30 @@ import (
 	"github.com/senzing-garage/go-logging/logging"
 	"github.com/senzing-garage/go-observing/observer"
 	"github.com/senzing-garage/serve-grpc/grpcserver"
+	szbatchpb "github.com/senzing-garage/serve-grpc/proto/go/szbatch"
+	szbulkpb "github.com/senzing-garage/serve-grpc/proto/go/szbulk"
+	szexportpb "github.com/senzing-garage/serve-grpc/proto/go/szexport"
 	"github.com/senzing-garage/sz-sdk-go-core/szabstractfactory"
 	"github.com/senzing-garage/sz-sdk-go-core/szdiagnostic"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	szenginepb "github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	szproductpb "github.com/senzing-garage/sz-sdk-proto/go/szproduct"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
 )
 
-var localLogger logging.Logging
+var (
+	errTest     = errors.New("test error")
+	localLogger logging.Logging
+)
 
 // ----------------------------------------------------------------------------
 // Internal functions
@@ -181,3 +205,669 @@ func TestGrpcServerImpl_Serve(test *testing.T) {
 	err = grpcServer.Serve(ctx)
 	require.NoError(test, err)
 }
+
+func TestGrpcServerImpl_Initialize_health(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableSzProduct:     true,
+		HealthCheckInterval: time.Second,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	serviceInfo := grpcServer.GetGRPCServer().GetServiceInfo()
+	require.Contains(test, serviceInfo, healthpb.Health_ServiceDesc.ServiceName)
+}
+
+func TestGrpcServerImpl_Initialize_healthSzEngine(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableSzEngine:      true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	// The services sharing the SzEngine are probed too.
+
+	healthClient := healthpb.NewHealthClient(serveBufconn(test, grpcServer))
+
+	for _, serviceName := range []string{"szengine.SzEngine", "szbatch.SzBatch", "szbulk.SzBulk", "szexport.SzExport"} {
+		response, err := healthClient.Check(ctx, &healthpb.HealthCheckRequest{Service: serviceName})
+		require.NoError(test, err)
+		require.Equal(test, healthpb.HealthCheckResponse_SERVING, response.GetStatus())
+	}
+}
+
+func TestGrpcServerImpl_GetMetricsHandler(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableSzProduct:     true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	require.Nil(test, grpcServer.GetMetricsHandler())
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequestWithContext(ctx, http.MethodGet, "/metrics", nil)
+	grpcServer.GetMetricsHandler().ServeHTTP(recorder, request)
+	require.Equal(test, http.StatusOK, recorder.Code)
+	require.Contains(test, recorder.Body.String(), "go_goroutines")
+}
+
+func TestGrpcServerImpl_Shutdown(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableAll:           true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+	err = grpcServer.Serve(ctx)
+	require.NoError(test, err)
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	err = grpcServer.Shutdown(shutdownCtx)
+	require.NoError(test, err)
+}
+
+func TestGrpcServerImpl_Shutdown_singlePort(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		EnableSzProduct:     true,
+		LogLevelName:        "INFO",
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+		SinglePort:          true,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	// Without a listener of its own, Serve runs until Shutdown.
+
+	served := make(chan error, 1)
+
+	go func() { served <- grpcServer.Serve(ctx) }()
+
+	select {
+	case err = <-served:
+		require.Fail(test, "Serve returned before Shutdown", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	err = grpcServer.Shutdown(shutdownCtx)
+	require.NoError(test, err)
+	require.NoError(test, <-served)
+}
+
+func TestGrpcServerImpl_Shutdown_healthChecks(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		EnableSzProduct:     true,
+		HealthCheckInterval: time.Millisecond,
+		LogLevelName:        "INFO",
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+		SinglePort:          true,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	served := make(chan error, 1)
+
+	go func() { served <- grpcServer.Serve(ctx) }()
+
+	// Shut down while the health checks probe the Senzing SDK every millisecond.
+
+	time.Sleep(50 * time.Millisecond)
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	err = grpcServer.Shutdown(shutdownCtx)
+	require.NoError(test, err)
+	require.NoError(test, <-served)
+}
+
+func TestGrpcServerImpl_Shutdown_notInitialized(test *testing.T) {
+	ctx := test.Context()
+	grpcServer := &grpcserver.BasicGrpcServer{}
+	err := grpcServer.Shutdown(ctx)
+	require.Error(test, err)
+}
+
+func TestStatusFromError(test *testing.T) {
+	require.NoError(test, grpcserver.StatusFromError(nil))
+
+	err := grpcserver.StatusFromError(errTest)
+	require.Equal(test, codes.Unknown, status.Code(err))
+	require.Equal(test, errTest.Error(), status.Convert(err).Message())
+
+	err = grpcserver.StatusFromError(context.Canceled)
+	require.Equal(test, codes.Canceled, status.Code(err))
+
+	statusErr := status.Error(codes.PermissionDenied, "denied")
+	require.Equal(test, statusErr, grpcserver.StatusFromError(statusErr))
+}
+
+func TestStatusFromError_senzing(test *testing.T) {
+	testCases := []struct {
+		senzingCode  int
+		expectedCode codes.Code
+	}{
+		{senzingCode: 33, expectedCode: codes.NotFound},
+		{senzingCode: 2233, expectedCode: codes.FailedPrecondition},
+	}
+
+	for _, testCase := range testCases {
+		test.Run(fmt.Sprintf("SENZ%04d", testCase.senzingCode), func(test *testing.T) {
+			message := fmt.Sprintf(
+				`{"id":"SZSDK60044001","reason":"SENZ%04d|Test reason","code":"SENZ%04d"}`,
+				testCase.senzingCode,
+				testCase.senzingCode,
+			)
+			senzingErr := wraperror.Errorf(szerror.New(testCase.senzingCode, message), wraperror.NoMessage)
+
+			err := grpcserver.StatusFromError(senzingErr)
+			require.Equal(test, testCase.expectedCode, status.Code(err))
+
+			details := status.Convert(err).Details()
+			require.Len(test, details, 1)
+			errorInfo, isErrorInfo := details[0].(*errdetails.ErrorInfo)
+			require.True(test, isErrorInfo)
+			require.Equal(test, grpcserver.ErrorInfoDomain, errorInfo.GetDomain())
+			require.Equal(test, "SZSDK60044001", errorInfo.GetMetadata()["id"])
+			require.Equal(test, fmt.Sprintf("SENZ%04d", testCase.senzingCode), errorInfo.GetMetadata()["code"])
+		})
+	}
+}
+
+func TestGrpcServerImpl_Authenticators(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	authenticator, err := grpcserver.NewAPIKeyAuthenticator(writeAPIKeysFile(test))
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		Authenticators:      []grpcserver.Authenticator{authenticator},
+		AvoidServing:        true,
+		EnableSzProduct:     true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	clientConn := serveBufconn(test, grpcServer)
+	productClient := szproductpb.NewSzProductClient(clientConn)
+
+	_, err = productClient.GetVersion(ctx, &szproductpb.GetVersionRequest{})
+	require.Equal(test, codes.Unauthenticated, status.Code(err))
+
+	_, err = productClient.GetVersion(metadata.AppendToOutgoingContext(ctx, "x-api-key", "wrong-key"), &szproductpb.GetVersionRequest{})
+	require.Equal(test, codes.Unauthenticated, status.Code(err))
+
+	_, err = productClient.GetVersion(metadata.AppendToOutgoingContext(ctx, "x-api-key", testAPIKey), &szproductpb.GetVersionRequest{})
+	require.NoError(test, err)
+
+	// Health checks need no credentials.
+
+	_, err = healthpb.NewHealthClient(clientConn).Check(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(test, err)
+}
+
+func TestGrpcServerImpl_SzBatch(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		BatchMaxItems:       3,
+		BatchWorkers:        2,
+		EnableSzEngine:      true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	clientConn := serveBufconn(test, grpcServer)
+	batchClient := szbatchpb.NewSzBatchClient(clientConn)
+
+	_, err = szenginepb.NewSzEngineClient(clientConn).AddRecord(
+		ctx,
+		&szenginepb.AddRecordRequest{DataSourceCode: "CUSTOMERS", RecordId: "BATCH-1", RecordDefinition: `{"NAME_FULL": "Al Jones"}`},
+	)
+	require.NoError(test, err)
+
+	// Results are in request order, and a missing record fails only its own result.
+
+	response, err := batchClient.GetRecords(ctx, &szbatchpb.GetRecordsRequest{
+		RecordKeys: []*szbatchpb.RecordKey{
+			{DataSourceCode: "CUSTOMERS", RecordId: "NO-SUCH-RECORD"},
+			{DataSourceCode: "CUSTOMERS", RecordId: "BATCH-1"},
+		},
+		Flags: senzing.SzRecordDefaultFlags,
+	})
+	require.NoError(test, err)
+	require.Len(test, response.GetResults(), 2)
+	require.NotZero(test, response.GetResults()[0].GetErrorCode())
+	require.Zero(test, response.GetResults()[1].GetErrorCode())
+	require.Contains(test, response.GetResults()[1].GetResult(), "BATCH-1")
+
+	response, err = batchClient.SearchByAttributes(ctx, &szbatchpb.SearchByAttributesRequest{
+		Attributes: []string{`{"NAME_FULL": "Al Jones"}`, `{"NAME_FULL": "Nobody"}`},
+		Flags:      senzing.SzSearchByAttributesDefaultFlags,
+	})
+	require.NoError(test, err)
+	require.Len(test, response.GetResults(), 2)
+
+	response, err = batchClient.GetEntities(ctx, &szbatchpb.GetEntitiesRequest{})
+	require.NoError(test, err)
+	require.Empty(test, response.GetResults())
+
+	// BatchMaxItems limits the size of a request.
+
+	_, err = batchClient.GetEntities(ctx, &szbatchpb.GetEntitiesRequest{EntityIds: []int64{1, 2, 3, 4}})
+	require.Equal(test, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGrpcServerImpl_SzBulk(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		BulkWorkers:         2,
+		EnableSzEngine:      true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	bulkClient := szbulkpb.NewSzBulkClient(serveBufconn(test, grpcServer))
+	requests := []*szenginepb.AddRecordRequest{
+		{DataSourceCode: "CUSTOMERS", RecordId: "BULK-1", RecordDefinition: `{"NAME_FULL": "Bob Smith"}`},
+		{DataSourceCode: "BADDATASOURCE", RecordId: "BULK-2", RecordDefinition: `{"NAME_FULL": "Ann Smith"}`},
+		{DataSourceCode: "CUSTOMERS", RecordId: "BULK-3", RecordDefinition: `{"NAME_FULL": "Jo Smith"}`},
+	}
+
+	stream, err := bulkClient.AddRecords(ctx)
+	require.NoError(test, err)
+
+	for _, request := range requests {
+		require.NoError(test, stream.Send(request))
+	}
+
+	require.NoError(test, stream.CloseSend())
+
+	responses := map[int64]*szbulkpb.BulkRecordResponse{}
+
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		require.NoError(test, err)
+
+		responses[response.GetSequence()] = response
+	}
+
+	require.Len(test, responses, len(requests))
+	require.Equal(test, "BULK-2", responses[1].GetRecordId())
+	require.NotZero(test, responses[1].GetErrorCode())
+	require.NotEmpty(test, responses[1].GetError())
+	require.Zero(test, responses[0].GetErrorCode())
+	require.Zero(test, responses[2].GetErrorCode())
+
+	// LoadRecords returns only a summary.
+
+	loadStream, err := bulkClient.LoadRecords(ctx)
+	require.NoError(test, err)
+
+	for _, request := range requests {
+		require.NoError(test, loadStream.Send(request))
+	}
+
+	summary, err := loadStream.CloseAndRecv()
+	require.NoError(test, err)
+	require.Equal(test, int64(3), summary.GetReceived())
+	require.Equal(test, int64(2), summary.GetSucceeded())
+	require.Equal(test, int64(1), summary.GetFailed())
+	require.Len(test, summary.GetFailures(), 1)
+	require.Equal(test, int64(1), summary.GetFailures()[0].GetSequence())
+}
+
+func TestGrpcServerImpl_SzBulk_progress(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:         true,
+		BulkProgressInterval: 1,
+		EnableSzEngine:       true,
+		LogLevelName:         "INFO",
+		Port:                 8258,
+		SenzingInstanceName:  "Test gRPC Server",
+		SenzingSettings:      senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	clientConn := serveBufconn(test, grpcServer)
+	bulkClient := szbulkpb.NewSzBulkClient(clientConn)
+
+	_, err = szenginepb.NewSzEngineClient(clientConn).AddRecord(
+		ctx,
+		&szenginepb.AddRecordRequest{DataSourceCode: "CUSTOMERS", RecordId: "BULK-4", RecordDefinition: `{"NAME_FULL": "Al Jones"}`},
+	)
+	require.NoError(test, err)
+
+	stream, err := bulkClient.ReevaluateRecords(ctx)
+	require.NoError(test, err)
+	require.NoError(test, stream.Send(&szenginepb.ReevaluateRecordRequest{DataSourceCode: "CUSTOMERS", RecordId: "BULK-4"}))
+	require.NoError(test, stream.Send(&szenginepb.ReevaluateRecordRequest{DataSourceCode: "CUSTOMERS", RecordId: "NO-SUCH-RECORD"}))
+	require.NoError(test, stream.CloseSend())
+
+	var (
+		progress []*szbulkpb.BulkProgress
+		results  []*szbulkpb.BulkRecordResponse
+	)
+
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		require.NoError(test, err)
+
+		if response.GetResult() != nil {
+			results = append(results, response.GetResult())
+		} else {
+			progress = append(progress, response.GetProgress())
+		}
+	}
+
+	// One progress message after each result and a final one.
+
+	require.Len(test, results, 2)
+	require.Len(test, progress, 3)
+
+	final := progress[len(progress)-1]
+	require.Equal(test, int64(2), final.GetCompleted())
+	require.Equal(test, final.GetCompleted(), final.GetSucceeded()+final.GetFailed()+final.GetNotFound())
+}
+
+func TestGrpcServerImpl_SzExport_resume(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableSzEngine:      true,
+		ExportResumption:    grpcserver.ExportResumption{CheckpointInterval: 1},
+		LogLevelName:        "INFO",
+		Port:                8258,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	clientConn := serveBufconn(test, grpcServer)
+	engineClient := szenginepb.NewSzEngineClient(clientConn)
+	exportClient := szexportpb.NewSzExportClient(clientConn)
+
+	for _, recordID := range []string{"EXPORT-1", "EXPORT-2", "EXPORT-3"} {
+		_, err = engineClient.AddRecord(ctx, &szenginepb.AddRecordRequest{
+			DataSourceCode:   "CUSTOMERS",
+			RecordId:         recordID,
+			RecordDefinition: `{"NAME_FULL": "` + recordID + ` Export"}`,
+		})
+		require.NoError(test, err)
+	}
+
+	receiveAll := func(resumeToken string) []*szexportpb.StreamEntityReportResponse {
+		stream, err := exportClient.StreamJsonEntityReport(ctx, &szexportpb.StreamJsonEntityReportRequest{
+			ResumeToken: resumeToken,
+		})
+		require.NoError(test, err)
+
+		var result []*szexportpb.StreamEntityReportResponse
+
+		for {
+			response, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return result
+			}
+
+			require.NoError(test, err)
+
+			result = append(result, response)
+		}
+	}
+
+	rows := receiveAll("")
+	require.GreaterOrEqual(test, len(rows), 2)
+	require.NotEmpty(test, rows[0].GetResumeToken())
+
+	// Resuming after the first row sends the rest.
+
+	resumedRows := receiveAll(rows[0].GetResumeToken())
+	require.Len(test, resumedRows, len(rows)-1)
+	require.Equal(test, int64(1), resumedRows[0].GetSequence())
+
+	// A token for another export is refused.
+
+	stream, err := exportClient.StreamJsonEntityReport(ctx, &szexportpb.StreamJsonEntityReportRequest{
+		Flags:       1,
+		ResumeToken: rows[0].GetResumeToken(),
+	})
+	require.NoError(test, err)
+
+	_, err = stream.Recv()
+	require.Equal(test, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGrpcServerImpl_SzExport_checkpoints(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:        true,
+		EnableSzEngine:      true,
+		LogLevelName:        "INFO",
+		Port:                8258,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	clientConn := serveBufconn(test, grpcServer)
+	engineClient := szenginepb.NewSzEngineClient(clientConn)
+	exportClient := szexportpb.NewSzExportClient(clientConn)
+
+	for _, recordID := range []string{"CHECKPOINT-1", "CHECKPOINT-2", "CHECKPOINT-3", "CHECKPOINT-4"} {
+		_, err = engineClient.AddRecord(ctx, &szenginepb.AddRecordRequest{
+			DataSourceCode:   "CUSTOMERS",
+			RecordId:         recordID,
+			RecordDefinition: `{"NAME_FULL": "` + recordID + ` Checkpoint"}`,
+		})
+		require.NoError(test, err)
+	}
+
+	receiveAll := func(request *szexportpb.StreamJsonEntityReportRequest) []*szexportpb.StreamEntityReportResponse {
+		stream, err := exportClient.StreamJsonEntityReport(ctx, request)
+		require.NoError(test, err)
+
+		var result []*szexportpb.StreamEntityReportResponse
+
+		for {
+			response, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return result
+			}
+
+			require.NoError(test, err)
+
+			result = append(result, response)
+		}
+	}
+
+	// Unfiltered exports have a resume token every CheckpointInterval rows of the export.
+
+	rows := receiveAll(&szexportpb.StreamJsonEntityReportRequest{CheckpointInterval: 2})
+	require.GreaterOrEqual(test, len(rows), 4)
+
+	for _, row := range rows {
+		require.Equal(test, (row.GetSequence()+1)%2 == 0, len(row.GetResumeToken()) > 0, row.GetSequence())
+	}
+
+	// So do resumed exports, counting from the start of the export.
+
+	resumedRows := receiveAll(&szexportpb.StreamJsonEntityReportRequest{
+		CheckpointInterval: 3,
+		ResumeToken:        rows[1].GetResumeToken(),
+	})
+	require.Equal(test, int64(2), resumedRows[0].GetSequence())
+
+	for _, row := range resumedRows {
+		require.Equal(test, (row.GetSequence()+1)%3 == 0, len(row.GetResumeToken()) > 0, row.GetSequence())
+	}
+
+	// Filtered exports have one every CheckpointInterval rows sent, whatever their sequence.
+
+	var secondRow struct {
+		ResolvedEntity struct {
+			EntityID int64 `json:"ENTITY_ID"`
+		} `json:"RESOLVED_ENTITY"`
+	}
+
+	require.NoError(test, json.Unmarshal([]byte(rows[1].GetResult()), &secondRow))
+
+	filteredRows := receiveAll(&szexportpb.StreamJsonEntityReportRequest{
+		CheckpointInterval: 2,
+		Filter:             &szexportpb.EntityFilter{MinEntityId: secondRow.ResolvedEntity.EntityID},
+	})
+	require.GreaterOrEqual(test, len(filteredRows), 2)
+	require.Equal(test, int64(1), filteredRows[0].GetSequence())
+
+	for index, row := range filteredRows {
+		require.Equal(test, (index+1)%2 == 0, len(row.GetResumeToken()) > 0, row.GetSequence())
+	}
+}
+
+// Serve grpcServer on an in-memory listener and return a client connection to it.
+func serveBufconn(test *testing.T, grpcServer *grpcserver.BasicGrpcServer) *grpc.ClientConn {
+	test.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+
+	go func() { _ = grpcServer.GetGRPCServer().Serve(listener) }()
+
+	test.Cleanup(grpcServer.GetGRPCServer().Stop)
+
+	clientConn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(test, err)
+
+	test.Cleanup(func() { _ = clientConn.Close() })
+
+	return clientConn
+}
+
+// Write contents to a file named name in a temporary directory and return its path.
+func writeTestFile(test *testing.T, name string, contents string) string {
+	test.Helper()
+
+	filename := filepath.Join(test.TempDir(), name)
+	require.NoError(test, os.WriteFile(filename, []byte(contents), 0o600))
+
+	return filename
+}
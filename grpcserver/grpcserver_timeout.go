@@ -0,0 +1,203 @@
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path"
+	"slices"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+A MethodTimeout bounds how long calls to Methods (path.Match patterns of full method
+names) may run.  Default applies when the client sets no deadline; Maximum shortens
+longer client deadlines.  Zero means no bound.
+*/
+type MethodTimeout struct {
+	Default time.Duration
+	Maximum time.Duration
+	Methods []string
+}
+
+// methodTimeoutJSON is the file format of a MethodTimeout, with durations such as "30s".
+type methodTimeoutJSON struct {
+	Default string   `json:"default"`
+	Maximum string   `json:"maximum"`
+	Methods []string `json:"methods"`
+}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewMethodTimeouts function reads MethodTimeouts from a JSON file such as
+
+	[
+	  {"methods": ["/szengine.SzEngine/FindNetwork*", "/szengine.SzEngine/WhySearch"], "default": "30s", "maximum": "2m"},
+	  {"methods": ["/szdiagnostic.SzDiagnostic/CheckRepositoryPerformance"], "maximum": "5m"}
+	]
+
+The first MethodTimeout listing a pattern that matches the method applies.
+
+Input
+  - filename: Path of the timeouts file.
+
+Output
+  - The MethodTimeouts in file order.
+*/
+func NewMethodTimeouts(filename string) ([]MethodTimeout, error) {
+	var entries []methodTimeoutJSON
+
+	fileBytes, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "os.ReadFile %s", filename)
+	}
+
+	err = json.Unmarshal(fileBytes, &entries)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal %s", filename)
+	}
+
+	result := make([]MethodTimeout, 0, len(entries))
+
+	for index, entry := range entries {
+		methodTimeout := MethodTimeout{Methods: entry.Methods}
+
+		methodTimeout.Default, err = parseOptionalDuration(entry.Default)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "%s: entry %d default", filename, index)
+		}
+
+		methodTimeout.Maximum, err = parseOptionalDuration(entry.Maximum)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "%s: entry %d maximum", filename, index)
+		}
+
+		if methodTimeout.Maximum > 0 && methodTimeout.Default > methodTimeout.Maximum {
+			return nil, wraperror.Errorf(errForPackage, "%s: entry %d default exceeds maximum", filename, index)
+		}
+
+		for _, method := range entry.Methods {
+			_, err = path.Match(method, "")
+			if err != nil {
+				return nil, wraperror.Errorf(err, "%s: entry %d has bad method pattern %q", filename, index, method)
+			}
+		}
+
+		result = append(result, methodTimeout)
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Apply the MethodTimeouts to unary calls.
+func (grpcServer *BasicGrpcServer) timeoutUnaryInterceptor(
+	ctx context.Context,
+	request any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	timeoutCtx, cancel := grpcServer.withMethodTimeout(ctx, info.FullMethod)
+	defer cancel()
+
+	response, err := handler(timeoutCtx, request)
+
+	return response, grpcServer.checkDeadline(timeoutCtx, info.FullMethod, err)
+}
+
+// Apply the MethodTimeouts to streams.
+func (grpcServer *BasicGrpcServer) timeoutStreamInterceptor(
+	server any,
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	timeoutCtx, cancel := grpcServer.withMethodTimeout(stream.Context(), info.FullMethod)
+	defer cancel()
+
+	err := handler(server, &contextServerStream{ServerStream: stream, ctx: timeoutCtx})
+
+	return grpcServer.checkDeadline(timeoutCtx, info.FullMethod, err)
+}
+
+// Set the deadline of the first MethodTimeout that matches fullMethod.
+func (grpcServer *BasicGrpcServer) withMethodTimeout(
+	ctx context.Context,
+	fullMethod string,
+) (context.Context, context.CancelFunc) {
+	for _, methodTimeout := range grpcServer.MethodTimeouts {
+		if !slices.ContainsFunc(methodTimeout.Methods, func(pattern string) bool {
+			isMatch, err := path.Match(pattern, fullMethod)
+
+			return err == nil && isMatch
+		}) {
+			continue
+		}
+
+		deadline, hasDeadline := ctx.Deadline()
+
+		switch {
+		case !hasDeadline && methodTimeout.Default > 0:
+			return context.WithTimeout(ctx, methodTimeout.Default)
+		case methodTimeout.Maximum > 0 && (!hasDeadline || time.Until(deadline) > methodTimeout.Maximum):
+			return context.WithTimeout(ctx, methodTimeout.Maximum)
+		default:
+			return ctx, func() {}
+		}
+	}
+
+	return ctx, func() {}
+}
+
+// Log calls that ran out of time, and make sure they fail with DeadlineExceeded.
+func (grpcServer *BasicGrpcServer) checkDeadline(ctx context.Context, fullMethod string, err error) error {
+	if err == nil || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+
+	deadline, _ := ctx.Deadline()
+	grpcServer.log(3004, fullMethod, deadline.Format(time.RFC3339Nano), err)
+
+	if status.Code(err) == codes.DeadlineExceeded {
+		return err
+	}
+
+	return status.Errorf(codes.DeadlineExceeded, "%s: deadline exceeded: %v", fullMethod, err)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func parseOptionalDuration(value string) (time.Duration, error) {
+	if len(value) == 0 {
+		return 0, nil
+	}
+
+	result, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, wraperror.Errorf(err, "time.ParseDuration %q", value)
+	}
+
+	if result < 0 {
+		return 0, wraperror.Errorf(errForPackage, "duration %q is negative", value)
+	}
+
+	return result, nil
+}
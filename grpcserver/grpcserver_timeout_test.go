@@ -0,0 +1,74 @@
+package grpcserver_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/settings"
+	"github.com/senzing-garage/serve-grpc/grpcserver"
+	szenginepb "github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestNewMethodTimeouts(test *testing.T) {
+	methodTimeouts, err := grpcserver.NewMethodTimeouts(writeTestFile(test, "timeouts.json", `[
+		{"methods": ["/szengine.SzEngine/FindNetwork*"], "default": "30s", "maximum": "2m"},
+		{"methods": ["/szdiagnostic.SzDiagnostic/CheckRepositoryPerformance"], "maximum": "5m"}
+	]`))
+	require.NoError(test, err)
+	require.Equal(test, []grpcserver.MethodTimeout{
+		{Default: 30 * time.Second, Maximum: 2 * time.Minute, Methods: []string{"/szengine.SzEngine/FindNetwork*"}},
+		{Maximum: 5 * time.Minute, Methods: []string{"/szdiagnostic.SzDiagnostic/CheckRepositoryPerformance"}},
+	}, methodTimeouts)
+}
+
+func TestNewMethodTimeouts_badFile(test *testing.T) {
+	_, err := grpcserver.NewMethodTimeouts(filepath.Join(test.TempDir(), "missing.json"))
+	require.Error(test, err)
+
+	_, err = grpcserver.NewMethodTimeouts(writeTestFile(test, "timeouts.json", `[{"methods": ["/*/*"], "default": "soon"}]`))
+	require.Error(test, err)
+
+	_, err = grpcserver.NewMethodTimeouts(writeTestFile(test, "timeouts.json", `[{"methods": ["/*/*"], "default": "2m", "maximum": "1m"}]`))
+	require.Error(test, err)
+
+	_, err = grpcserver.NewMethodTimeouts(writeTestFile(test, "timeouts.json", `[{"methods": ["/sz["], "default": "1m"}]`))
+	require.Error(test, err)
+}
+
+func TestGrpcServerImpl_MethodTimeouts(test *testing.T) {
+	ctx := test.Context()
+
+	senzingsettings, err := settings.BuildSimpleSettingsUsingEnvVars()
+	require.NoError(test, err)
+
+	grpcServer := &grpcserver.BasicGrpcServer{
+		AvoidServing:   true,
+		EnableSzEngine: true,
+		LogLevelName:   "INFO",
+		MethodTimeouts: []grpcserver.MethodTimeout{
+			{Default: time.Nanosecond, Methods: []string{"/szengine.SzEngine/StreamExport*"}},
+		},
+		Port:                8258,
+		SenzingInstanceName: "Test gRPC Server",
+		SenzingSettings:     senzingsettings,
+	}
+
+	err = grpcServer.Initialize(ctx)
+	require.NoError(test, err)
+
+	engineClient := szenginepb.NewSzEngineClient(serveBufconn(test, grpcServer))
+
+	stream, err := engineClient.StreamExportJsonEntityReport(ctx, &szenginepb.StreamExportJsonEntityReportRequest{})
+	require.NoError(test, err)
+
+	_, err = stream.Recv()
+	require.Equal(test, codes.DeadlineExceeded, status.Code(err))
+}
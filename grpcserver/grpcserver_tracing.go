@@ -0,0 +1,33 @@
+package grpcserver
+
+import (
+	"github.com/senzing-garage/serve-grpc/tracing"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc/filters"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/stats"
+)
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (grpcServer *BasicGrpcServer) getTracerProvider() trace.TracerProvider {
+	if grpcServer.TracerProvider != nil {
+		return grpcServer.TracerProvider
+	}
+
+	return otel.GetTracerProvider()
+}
+
+// Create a server span for every RPC, continuing the W3C trace-context found in the incoming metadata.
+// grpc-web calls arrive through grpc.Server.ServeHTTP, where HTTP headers become metadata,
+// so they are traced the same way.  Health checks are not traced.
+func (grpcServer *BasicGrpcServer) getTracingStatsHandler() stats.Handler {
+	return otelgrpc.NewServerHandler(
+		otelgrpc.WithFilter(filters.Not(filters.HealthCheck())),
+		otelgrpc.WithPropagators(tracing.Propagator()),
+		otelgrpc.WithTracerProvider(grpcServer.getTracerProvider()),
+	)
+}
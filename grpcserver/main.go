@@ -3,6 +3,7 @@ package grpcserver
 import (
 	"context"
 	"errors"
+	"time"
 )
 
 // ----------------------------------------------------------------------------
@@ -11,6 +12,7 @@ import (
 
 type GrpcServer interface {
 	Serve(ctx context.Context) error
+	Shutdown(ctx context.Context) error
 }
 
 // ----------------------------------------------------------------------------
@@ -26,6 +28,9 @@ const Prefix = "serve-grpc.grpcserver."
 // Default gRPC Observer port.
 const DefaultGrpcObserverPort = "8260"
 
+// Default time between health probes of the Senzing SDK.
+const DefaultHealthCheckInterval = 10 * time.Second
+
 // ----------------------------------------------------------------------------
 // Variables
 // ----------------------------------------------------------------------------
@@ -34,12 +39,41 @@ const DefaultGrpcObserverPort = "8260"
 var IDMessages = map[int]string{
 	1000: "Entry: %+v",
 	1001: "SENZING_ENGINE_CONFIGURATION_JSON: %v",
+	1002: "Call to %s authenticated by %s as '%s'.",
+	1003: "Call to %s by '%s' throttled by the %s rate limit. Retry after %s.",
+	1004: "Redo processing progress.",
 	2002: "Enabling all services.",
 	2003: "Server listening at %v",
 	2004: "Serving avoided.",
+	2005: "Shutting down gRPC server.",
+	2006: "gRPC server shut down.",
+	2007: "Health of service '%s' is %s. Probe error: %v",
+	2008: "Serving read-only. Calls that change the Senzing repository or its configuration are refused.",
+	2009: "Redo processing started with %d workers.",
+	2010: "Redo processing paused.",
+	2011: "Redo processing resumed.",
+	2012: "Redo processing stopped. %d redo records processed, %d failed.",
+	2013: "Closed export %d of '%s' after %s without use.",
+	2014: "Job %s (%s) submitted by %q.",
+	2015: "Job %s (%s) ended %s.",
+	2016: "Serving gRPC calls on the HTTP server's port.",
+	3001: "Graceful shutdown did not complete (%v). Cancelling in-flight calls.",
+	3002: "Call to %s rejected as unauthenticated: %v",
+	3003: "Call to %s by '%s' denied: %s",
+	3004: "Call to %s exceeded its deadline of %s: %v",
+	3005: "Redo processing failed: %v",
+	3006: "Closing exports failed: %v",
+	3007: "Job %s (%s) failed: %v",
+	3008: "Saving jobs to %s failed: %v",
+	3009: "Redo workers did not stop (%v). Abandoning the redo records they are processing.",
+	3010: "Jobs did not stop (%v). Abandoning them.",
 	4001: "Call to net.Listen(tcp, %s) failed.",
 	4002: "Call to Szdiagnostic.PurgeRepository() failed.",
 	4003: "Call to Szengine.Destroy() failed.",
+	4004: "Call to Szdiagnostic.Destroy() failed.",
+	4005: "Call to Szconfigmanager.Destroy() failed.",
+	4006: "Call to Szconfigmanager.Destroy() for SzConfig failed.",
+	4007: "Call to Szproduct.Destroy() failed.",
 	5001: "Failed to serve.",
 }
 
@@ -2,8 +2,12 @@ package httpserver
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
@@ -18,16 +22,28 @@ import (
 
 // BasicHTTPServer is the default implementation of the HttpServer interface.
 type BasicHTTPServer struct {
-	AvoidServing      bool
-	EnableAll         bool
-	EnableGRPC        bool
-	GRPCRoutePrefix   string
-	GRPCServer        *grpc.Server
-	logger            logging.Logging
-	LogLevelName      string
-	ReadHeaderTimeout time.Duration
-	ServerAddress     string
-	ServerPort        int
+	AvoidServing         bool
+	CORSPolicy           *CORSPolicy
+	EnableAll            bool
+	EnableGRPC           bool
+	EnableREST           bool
+	EnableWebSocket      bool
+	GRPCRoutePrefix      string
+	GRPCServer           *grpc.Server
+	isShutdown           bool
+	logger               logging.Logging
+	LogLevelName         string
+	MetricsHandler       http.Handler
+	MetricsPath          string
+	ReadHeaderTimeout    time.Duration
+	RESTRoutePrefix      string
+	server               *http.Server
+	serverMutex          sync.Mutex
+	ServerAddress        string
+	ServerPort           int
+	SinglePort           bool        // Also serve native gRPC calls to GRPCServer, including HTTP/2 without TLS (h2c).
+	TLSConfig            *tls.Config // Serve HTTPS, and HTTP/2 when clients negotiate "h2". Nil serves plain HTTP.
+	WebSocketRoutePrefix string
 }
 
 const OptionCallerSkip = 3
@@ -70,29 +86,101 @@ func (httpServer *BasicHTTPServer) Serve(ctx context.Context) error {
 
 	httpServer.registerGRPC(ctx, rootMux)
 
+	// Enable the REST gateway and its OpenAPI document.
+
+	httpServer.registerREST(ctx, rootMux)
+	httpServer.registerOpenAPI(ctx, rootMux)
+
+	// Enable the WebSocket streams.
+
+	httpServer.registerWebSocket(ctx, rootMux)
+
+	// Enable Prometheus metrics.
+
+	httpServer.registerMetrics(ctx, rootMux)
+
 	// Start service.
 
 	listenOnAddress := fmt.Sprintf("%s:%v", httpServer.ServerAddress, httpServer.ServerPort)
 	httpServer.log(2001, listenOnAddress)
 
-	server := http.Server{
+	handler := httpServer.CORSHandler(rootMux)
+
+	if httpServer.SinglePort {
+		handler = httpServer.grpcMultiplexer(handler)
+		httpServer.log(2007, httpServer.scheme(), httpServer.ServerPort)
+	}
+
+	server := &http.Server{
 		ReadHeaderTimeout: httpServer.ReadHeaderTimeout,
 		Addr:              listenOnAddress,
-		Handler:           rootMux,
+		Handler:           handler,
+		Protocols:         httpServer.protocols(),
+		TLSConfig:         httpServer.TLSConfig,
+	}
+
+	httpServer.serverMutex.Lock()
+	if httpServer.isShutdown {
+		httpServer.serverMutex.Unlock()
+
+		return wraperror.Errorf(err, wraperror.NoMessage)
 	}
 
+	httpServer.server = server
+	httpServer.serverMutex.Unlock()
+
 	// Start a web browser.  Unless disabled.
 
 	if !httpServer.AvoidServing {
-		err = server.ListenAndServe()
-		if err != nil {
-			panic(err)
+		if httpServer.TLSConfig != nil {
+			// The certificate comes from TLSConfig, so no files are named.
+			err = server.ListenAndServeTLS("", "")
+		} else {
+			err = server.ListenAndServe()
+		}
+
+		if errors.Is(err, http.ErrServerClosed) {
+			err = nil
 		}
 	}
 
 	return wraperror.Errorf(err, wraperror.NoMessage)
 }
 
+/*
+The Shutdown method stops the HTTP server.
+The listener is closed immediately and in-flight requests are allowed to
+finish until ctx is done.
+
+Input
+  - ctx: A context to control lifecycle. Its deadline bounds the graceful drain.
+
+Output
+  - Nothing is returned, except for an error.
+*/
+func (httpServer *BasicHTTPServer) Shutdown(ctx context.Context) error {
+	var err error
+
+	httpServer.serverMutex.Lock()
+	server := httpServer.server
+	httpServer.isShutdown = true
+	httpServer.serverMutex.Unlock()
+
+	if server == nil {
+		return wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	httpServer.log(2003)
+
+	err = server.Shutdown(ctx)
+	if err != nil {
+		httpServer.log(3001, err)
+		err = server.Close()
+	}
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
 // ----------------------------------------------------------------------------
 // Private methods
 // ----------------------------------------------------------------------------
@@ -103,7 +191,8 @@ func (httpServer *BasicHTTPServer) grpcFunc(ctx context.Context) http.HandlerFun
 	_ = ctx
 
 	wrappedGrpcOptions = append(wrappedGrpcOptions, grpcweb.WithCorsForRegisteredEndpointsOnly(false))
-	wrappedGrpcOptions = append(wrappedGrpcOptions, grpcweb.WithOriginFunc(func(_ string) bool { return true }))
+	// CORS headers are written by CORSHandler, so that one CORSPolicy covers grpc-web, REST and OpenAPI.
+	wrappedGrpcOptions = append(wrappedGrpcOptions, grpcweb.WithOriginFunc(func(_ string) bool { return false }))
 
 	wrappedGrpc := grpcweb.WrapServer(httpServer.GRPCServer, wrappedGrpcOptions...)
 
@@ -122,6 +211,32 @@ func (httpServer *BasicHTTPServer) grpcFunc(ctx context.Context) http.HandlerFun
 	})
 }
 
+/*
+Send native gRPC calls, which are HTTP/2 requests of content type application/grpc, to
+GRPCServer.  Everything else, including grpc-web, goes to handler.
+*/
+func (httpServer *BasicHTTPServer) grpcMultiplexer(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		if isNativeGRPC(request) {
+			httpServer.GRPCServer.ServeHTTP(response, request)
+
+			return
+		}
+
+		handler.ServeHTTP(response, request)
+	})
+}
+
+// HTTP/1.1 and HTTP/2 over TLS.  In single-port mode also HTTP/2 over plaintext, as used by gRPC clients without TLS.
+func (httpServer *BasicHTTPServer) protocols() *http.Protocols {
+	result := &http.Protocols{}
+	result.SetHTTP1(true)
+	result.SetHTTP2(true)
+	result.SetUnencryptedHTTP2(httpServer.SinglePort)
+
+	return result
+}
+
 func (httpServer *BasicHTTPServer) registerGRPC(ctx context.Context, rootMux *http.ServeMux) {
 	if httpServer.EnableAll || httpServer.EnableGRPC {
 		pattern := fmt.Sprintf("/%s/", httpServer.GRPCRoutePrefix)
@@ -129,8 +244,34 @@ func (httpServer *BasicHTTPServer) registerGRPC(ctx context.Context, rootMux *ht
 		grpcWebMux := httpServer.Handler(ctx)
 		handler := http.StripPrefix(prefix, grpcWebMux)
 		rootMux.Handle(pattern, handler)
-		httpServer.log(2002, httpServer.ServerPort, httpServer.GRPCRoutePrefix)
+		httpServer.log(2002, httpServer.scheme(), httpServer.ServerPort, httpServer.GRPCRoutePrefix)
+	}
+}
+
+func (httpServer *BasicHTTPServer) registerMetrics(ctx context.Context, rootMux *http.ServeMux) {
+	_ = ctx
+
+	if httpServer.MetricsHandler != nil && len(httpServer.MetricsPath) > 0 {
+		rootMux.Handle(httpServer.MetricsPath, httpServer.MetricsHandler)
+		httpServer.log(2004, httpServer.scheme(), httpServer.ServerPort, httpServer.MetricsPath)
+	}
+}
+
+// --- Helpers -------------------------------------------------------------------------
+
+func isNativeGRPC(request *http.Request) bool {
+	contentType := request.Header.Get("Content-Type")
+
+	return request.ProtoMajor == 2 && (contentType == "application/grpc" || strings.HasPrefix(contentType, "application/grpc+"))
+}
+
+// The URL scheme of the served endpoints, for log messages.
+func (httpServer *BasicHTTPServer) scheme() string {
+	if httpServer.TLSConfig != nil {
+		return "https"
 	}
+
+	return "http"
 }
 
 // --- Logging -------------------------------------------------------------------------
@@ -0,0 +1,428 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+A CORSRule says which other origins may call the server from a browser.
+
+AllowedOrigins are exact origins such as "https://app.example.com", wildcards such
+as "https://*.example.com" where * stands for one or more DNS labels, "*" for any
+origin, or regular expressions prefixed with "regexp:".
+*/
+type CORSRule struct {
+	AllowCredentials bool          // Allow cookies and HTTP authentication; not allowed with the "*" origin.
+	AllowedHeaders   []string      // Request headers besides the grpc-web protocol headers; nil uses DefaultCORSAllowedHeaders.
+	AllowedOrigins   []string      // Origins allowed; none means cross-origin requests are refused.
+	ExposedHeaders   []string      // Response headers the page may read.
+	MaxAge           time.Duration // How long browsers may cache a preflight response; zero leaves it to the browser.
+}
+
+/*
+A CORSRoute overrides the CORSRule for Paths.  A path ending in "/" matches the
+paths below it, as in http.ServeMux; other paths match themselves.
+*/
+type CORSRoute struct {
+	CORSRule
+
+	Paths []string
+}
+
+/*
+A CORSPolicy is the CORSRule of the first CORSRoute matching the request path, or
+else the default CORSRule.
+*/
+type CORSPolicy struct {
+	CORSRule
+
+	Routes []CORSRoute
+}
+
+// corsRuleJSON is the file format of a CORSRule.  Unset fields of a route are inherited.
+type corsRuleJSON struct {
+	AllowCredentials *bool    `json:"allowCredentials"`
+	AllowedHeaders   []string `json:"allowedHeaders"`
+	AllowedOrigins   []string `json:"allowedOrigins"`
+	ExposedHeaders   []string `json:"exposedHeaders"`
+	MaxAge           string   `json:"maxAge"` // Duration such as "10m".
+}
+
+type corsRouteJSON struct {
+	corsRuleJSON
+
+	Paths []string `json:"paths"`
+}
+
+type corsPolicyJSON struct {
+	corsRuleJSON
+
+	Routes []corsRouteJSON `json:"routes"`
+}
+
+// corsEnforcer applies a CORSPolicy with its origins compiled.
+type corsEnforcer struct {
+	defaultRule compiledCORSRule
+	routes      []compiledCORSRoute
+}
+
+type compiledCORSRule struct {
+	CORSRule
+
+	allowAnyOrigin bool
+	origins        []*regexp.Regexp
+}
+
+type compiledCORSRoute struct {
+	paths []string
+	rule  compiledCORSRule
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const corsRegexpPrefix = "regexp:"
+
+// The DNS labels matched by * in a wildcard origin.
+const corsWildcardLabels = `[A-Za-z0-9-]+(\.[A-Za-z0-9-]+)*`
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// DefaultCORSAllowedHeaders are the request headers allowed when a CORSRule lists none.
+var DefaultCORSAllowedHeaders = []string{"Authorization", "Traceparent", "Tracestate", "X-Api-Key"}
+
+// Request headers of the grpc-web protocol, always allowed.
+var corsProtocolHeaders = []string{"Content-Type", "Grpc-Timeout", "X-Grpc-Web", "X-User-Agent"}
+
+var corsAllowedMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewCORSPolicy function reads a CORSPolicy from a JSON file such as
+
+	{
+	  "allowedOrigins": ["https://app.example.com", "https://*.example.org"],
+	  "allowCredentials": true,
+	  "maxAge": "10m",
+	  "routes": [
+	    {"paths": ["/api/v1/"], "allowedOrigins": ["regexp:^https://analyst-[0-9]+\\.example\\.com$"]}
+	  ]
+	}
+
+Fields a route does not set are those of the default rule.
+
+Input
+  - filename: Path of the CORS policy file.
+
+Output
+  - CORSPolicy.
+*/
+func NewCORSPolicy(filename string) (*CORSPolicy, error) {
+	var policyJSON corsPolicyJSON
+
+	fileBytes, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "os.ReadFile %s", filename)
+	}
+
+	err = json.Unmarshal(fileBytes, &policyJSON)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "json.Unmarshal %s", filename)
+	}
+
+	result := &CORSPolicy{}
+
+	result.CORSRule, err = policyJSON.rule(CORSRule{})
+	if err != nil {
+		return nil, wraperror.Errorf(err, "%s: default rule", filename)
+	}
+
+	for index, routeJSON := range policyJSON.Routes {
+		if len(routeJSON.Paths) == 0 {
+			return nil, wraperror.Errorf(errForPackage, "%s: route %d has no paths", filename, index)
+		}
+
+		route := CORSRoute{Paths: routeJSON.Paths}
+
+		route.CORSRule, err = routeJSON.rule(result.CORSRule)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "%s: route %d", filename, index)
+		}
+
+		result.Routes = append(result.Routes, route)
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+The CORSHandler method wraps handler with the CORSPolicy.  Preflight requests are
+answered without calling handler.  Requests from other origins that the policy does
+not allow are refused with 403 Forbidden, so that even requests a browser sends
+without a preflight never reach the Senzing services.  Requests without an Origin
+header, or from the server's own origin, are passed on unchanged.
+
+Input
+  - handler: The handler of allowed requests.
+
+Output
+  - The wrapped handler.
+*/
+func (httpServer *BasicHTTPServer) CORSHandler(handler http.Handler) http.Handler {
+	enforcer := newCORSEnforcer(httpServer.CORSPolicy)
+
+	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		origin := request.Header.Get("Origin")
+		if len(origin) == 0 || isSameOrigin(request, origin) {
+			handler.ServeHTTP(response, request)
+
+			return
+		}
+
+		response.Header().Add("Vary", "Origin")
+
+		isPreflight := request.Method == http.MethodOptions && len(request.Header.Get("Access-Control-Request-Method")) > 0
+		if isPreflight {
+			response.Header().Add("Vary", "Access-Control-Request-Method")
+			response.Header().Add("Vary", "Access-Control-Request-Headers")
+		}
+
+		rule := enforcer.ruleFor(request.URL.Path)
+
+		refusal := rule.refusal(request, origin, isPreflight)
+		if len(refusal) > 0 {
+			httpServer.log(3002, origin, request.Method, request.URL.Path, refusal)
+			writeProblem(response, request, status.New(codes.PermissionDenied, refusal))
+
+			return
+		}
+
+		response.Header().Set("Access-Control-Allow-Origin", origin)
+
+		if rule.AllowCredentials {
+			response.Header().Set("Access-Control-Allow-Credentials", "true")
+		}
+
+		if !isPreflight {
+			if len(rule.ExposedHeaders) > 0 {
+				response.Header().Set("Access-Control-Expose-Headers", strings.Join(rule.ExposedHeaders, ", "))
+			}
+
+			handler.ServeHTTP(response, request)
+
+			return
+		}
+
+		response.Header().Set("Access-Control-Allow-Methods", strings.Join(corsAllowedMethods, ", "))
+
+		if requested := request.Header.Get("Access-Control-Request-Headers"); len(requested) > 0 {
+			response.Header().Set("Access-Control-Allow-Headers", requested)
+		}
+
+		if rule.MaxAge > 0 {
+			response.Header().Set("Access-Control-Max-Age", strconv.Itoa(int(rule.MaxAge.Seconds())))
+		}
+
+		response.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Resolve a rule from its file format, taking unset fields from inherited.
+func (ruleJSON corsRuleJSON) rule(inherited CORSRule) (CORSRule, error) {
+	result := inherited
+
+	if ruleJSON.AllowCredentials != nil {
+		result.AllowCredentials = *ruleJSON.AllowCredentials
+	}
+
+	if ruleJSON.AllowedHeaders != nil {
+		result.AllowedHeaders = ruleJSON.AllowedHeaders
+	}
+
+	if ruleJSON.AllowedOrigins != nil {
+		result.AllowedOrigins = ruleJSON.AllowedOrigins
+	}
+
+	if ruleJSON.ExposedHeaders != nil {
+		result.ExposedHeaders = ruleJSON.ExposedHeaders
+	}
+
+	if len(ruleJSON.MaxAge) > 0 {
+		maxAge, err := time.ParseDuration(ruleJSON.MaxAge)
+		if err != nil {
+			return result, wraperror.Errorf(err, "time.ParseDuration %q", ruleJSON.MaxAge)
+		}
+
+		result.MaxAge = maxAge
+	}
+
+	_, err := compileCORSRule(result)
+
+	return result, err
+}
+
+// The rule of the first route matching path.
+func (enforcer *corsEnforcer) ruleFor(path string) compiledCORSRule {
+	for _, route := range enforcer.routes {
+		if slices.ContainsFunc(route.paths, func(pattern string) bool {
+			if strings.HasSuffix(pattern, "/") {
+				return strings.HasPrefix(path, pattern)
+			}
+
+			return path == pattern
+		}) {
+			return route.rule
+		}
+	}
+
+	return enforcer.defaultRule
+}
+
+// Why the rule refuses a request from origin, or "" if it allows it.
+func (rule compiledCORSRule) refusal(request *http.Request, origin string, isPreflight bool) string {
+	if !rule.allowsOrigin(origin) {
+		return "origin " + origin + " is not allowed"
+	}
+
+	if !isPreflight {
+		return ""
+	}
+
+	method := request.Header.Get("Access-Control-Request-Method")
+	if !slices.Contains(corsAllowedMethods, method) {
+		return "method " + method + " is not allowed"
+	}
+
+	allowedHeaders := rule.AllowedHeaders
+	if allowedHeaders == nil {
+		allowedHeaders = DefaultCORSAllowedHeaders
+	}
+
+	for header := range strings.SplitSeq(request.Header.Get("Access-Control-Request-Headers"), ",") {
+		header = strings.TrimSpace(header)
+		if len(header) == 0 {
+			continue
+		}
+
+		isAllowed := func(allowed string) bool { return strings.EqualFold(allowed, header) }
+		if !slices.ContainsFunc(corsProtocolHeaders, isAllowed) && !slices.ContainsFunc(allowedHeaders, isAllowed) {
+			return "header " + header + " is not allowed"
+		}
+	}
+
+	return ""
+}
+
+func (rule compiledCORSRule) allowsOrigin(origin string) bool {
+	if rule.allowAnyOrigin {
+		return true
+	}
+
+	return slices.ContainsFunc(rule.origins, func(pattern *regexp.Regexp) bool { return pattern.MatchString(origin) })
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+/*
+Compile policy.  Without a policy no other origin is allowed.  Origins that do not
+compile, which NewCORSPolicy would have refused, never match.
+*/
+func newCORSEnforcer(policy *CORSPolicy) *corsEnforcer {
+	result := &corsEnforcer{}
+
+	if policy == nil {
+		return result
+	}
+
+	result.defaultRule, _ = compileCORSRule(policy.CORSRule)
+
+	for _, route := range policy.Routes {
+		rule, _ := compileCORSRule(route.CORSRule)
+		result.routes = append(result.routes, compiledCORSRoute{paths: route.Paths, rule: rule})
+	}
+
+	return result
+}
+
+func compileCORSRule(rule CORSRule) (compiledCORSRule, error) {
+	var err error
+
+	result := compiledCORSRule{CORSRule: rule}
+
+	for _, origin := range rule.AllowedOrigins {
+		var pattern *regexp.Regexp
+
+		switch {
+		case origin == "*" && rule.AllowCredentials:
+			err = wraperror.Errorf(errForPackage, "allowCredentials cannot be used with the * origin")
+
+			continue
+		case origin == "*":
+			result.allowAnyOrigin = true
+
+			continue
+		case strings.HasPrefix(origin, corsRegexpPrefix):
+			var compileErr error
+
+			pattern, compileErr = regexp.Compile(`^(?:` + strings.TrimPrefix(origin, corsRegexpPrefix) + `)$`)
+			if compileErr != nil {
+				err = wraperror.Errorf(compileErr, "origin %q", origin)
+
+				continue
+			}
+		default:
+			quoted := strings.Split(origin, "*")
+			for index := range quoted {
+				quoted[index] = regexp.QuoteMeta(quoted[index])
+			}
+
+			pattern = regexp.MustCompile(`^` + strings.Join(quoted, corsWildcardLabels) + `$`)
+		}
+
+		result.origins = append(result.origins, pattern)
+	}
+
+	return result, err
+}
+
+// Whether origin is the origin the request was sent to.
+func isSameOrigin(request *http.Request, origin string) bool {
+	originURL, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(originURL.Host, request.Host)
+}
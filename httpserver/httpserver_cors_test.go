@@ -0,0 +1,208 @@
+package httpserver_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/serve-grpc/httpserver"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestNewCORSPolicy(test *testing.T) {
+	corsPolicy, err := httpserver.NewCORSPolicy(writeTestFile(test, "cors-policy.json", `{
+		"allowedOrigins": ["https://app.example.com"],
+		"allowCredentials": true,
+		"maxAge": "10m",
+		"routes": [
+			{"paths": ["/api/v1/"], "allowedOrigins": ["https://*.example.org"]}
+		]
+	}`))
+	require.NoError(test, err)
+	require.Equal(test, []string{"https://app.example.com"}, corsPolicy.AllowedOrigins)
+	require.Equal(test, 10*time.Minute, corsPolicy.MaxAge)
+	require.Len(test, corsPolicy.Routes, 1)
+
+	// Routes inherit the fields they do not set.
+
+	require.Equal(test, []string{"https://*.example.org"}, corsPolicy.Routes[0].AllowedOrigins)
+	require.True(test, corsPolicy.Routes[0].AllowCredentials)
+	require.Equal(test, 10*time.Minute, corsPolicy.Routes[0].MaxAge)
+}
+
+func TestNewCORSPolicy_badFile(test *testing.T) {
+	_, err := httpserver.NewCORSPolicy(filepath.Join(test.TempDir(), "missing.json"))
+	require.Error(test, err)
+
+	_, err = httpserver.NewCORSPolicy(writeTestFile(test, "cors-policy.json", `{"allowedOrigins": `))
+	require.Error(test, err)
+
+	_, err = httpserver.NewCORSPolicy(writeTestFile(test, "cors-policy.json", `{"allowedOrigins": ["*"], "allowCredentials": true}`))
+	require.Error(test, err)
+
+	_, err = httpserver.NewCORSPolicy(writeTestFile(test, "cors-policy.json", `{"allowedOrigins": ["regexp:("]}`))
+	require.Error(test, err)
+
+	_, err = httpserver.NewCORSPolicy(writeTestFile(test, "cors-policy.json", `{"maxAge": "soon"}`))
+	require.Error(test, err)
+
+	_, err = httpserver.NewCORSPolicy(writeTestFile(test, "cors-policy.json", `{"routes": [{"allowedOrigins": ["*"]}]}`))
+	require.Error(test, err)
+}
+
+func TestBasicHTTPServer_CORSHandler_preflight(test *testing.T) {
+	ctx := test.Context()
+	handler := getCORSTestHandler(ctx, test)
+
+	testCases := []struct {
+		name    string
+		headers string
+		origin  string
+		path    string
+	}{
+		{name: "exact", origin: "https://app.example.com", path: "/grpc/szengine.SzEngine/GetEntityByEntityId", headers: "content-type, x-grpc-web, x-api-key"},
+		{name: "wildcard", origin: "https://a.b.example.net", path: "/grpc/szproduct.SzProduct/GetVersion"},
+		{name: "regexp", origin: "https://analyst-42.example.com", path: "/api/v1/search", headers: "Authorization"},
+	}
+
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			request := httptest.NewRequestWithContext(ctx, http.MethodOptions, testCase.path, nil)
+			request.Header.Set("Origin", testCase.origin)
+			request.Header.Set("Access-Control-Request-Method", http.MethodPost)
+			request.Header.Set("Access-Control-Request-Headers", testCase.headers)
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+			require.Equal(test, http.StatusNoContent, recorder.Code)
+			require.Equal(test, testCase.origin, recorder.Header().Get("Access-Control-Allow-Origin"))
+			require.Contains(test, recorder.Header().Get("Access-Control-Allow-Methods"), http.MethodPost)
+			require.Equal(test, testCase.headers, recorder.Header().Get("Access-Control-Allow-Headers"))
+			require.Equal(test, "600", recorder.Header().Get("Access-Control-Max-Age"))
+			require.Contains(test, recorder.Header().Values("Vary"), "Origin")
+			require.Empty(test, recorder.Body.String(), "preflight requests must not reach the handler")
+		})
+	}
+}
+
+func TestBasicHTTPServer_CORSHandler_rejected(test *testing.T) {
+	ctx := test.Context()
+	handler := getCORSTestHandler(ctx, test)
+
+	testCases := []struct {
+		name    string
+		headers string
+		method  string
+		origin  string
+		path    string
+	}{
+		{name: "unknown origin", origin: "https://evil.example", path: "/grpc/szproduct.SzProduct/GetVersion", method: http.MethodOptions},
+		{name: "unknown origin without preflight", origin: "https://evil.example", path: "/api/v1/search", method: http.MethodPost},
+		{name: "wildcard suffix", origin: "https://example.net.evil.example", path: "/api/v1/product/version", method: http.MethodGet},
+		{name: "wildcard needs a label", origin: "https://.example.net", path: "/api/v1/product/version", method: http.MethodGet},
+		{name: "regexp is anchored", origin: "https://analyst-42.example.com.evil.example", path: "/api/v1/search", method: http.MethodPost},
+		{name: "route override", origin: "https://a.example.net", path: "/api/v1/search", method: http.MethodPost},
+		{name: "header", origin: "https://app.example.com", path: "/grpc/szproduct.SzProduct/GetVersion", method: http.MethodOptions, headers: "x-secret"},
+	}
+
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			request := httptest.NewRequestWithContext(ctx, testCase.method, testCase.path, nil)
+			request.Header.Set("Origin", testCase.origin)
+
+			if testCase.method == http.MethodOptions {
+				request.Header.Set("Access-Control-Request-Method", http.MethodPost)
+				request.Header.Set("Access-Control-Request-Headers", testCase.headers)
+			}
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+			require.Equal(test, http.StatusForbidden, recorder.Code)
+			require.Empty(test, recorder.Header().Get("Access-Control-Allow-Origin"))
+			require.Equal(test, "application/problem+json", recorder.Header().Get("Content-Type"))
+		})
+	}
+}
+
+func TestBasicHTTPServer_CORSHandler_allowed(test *testing.T) {
+	ctx := test.Context()
+	handler := getCORSTestHandler(ctx, test)
+
+	// A cross-origin request from an allowed origin.
+
+	request := httptest.NewRequestWithContext(ctx, http.MethodGet, "/grpc/szproduct.SzProduct/GetVersion", nil)
+	request.Header.Set("Origin", "https://app.example.com")
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	require.Equal(test, http.StatusOK, recorder.Code)
+	require.Equal(test, "handled", recorder.Body.String())
+	require.Equal(test, "https://app.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(test, "true", recorder.Header().Get("Access-Control-Allow-Credentials"))
+	require.Equal(test, "Grpc-Status, Grpc-Message", recorder.Header().Get("Access-Control-Expose-Headers"))
+
+	// Requests without an Origin, or from the server's own origin, are not CORS requests.
+
+	for _, origin := range []string{"", "http://example.com"} {
+		request = httptest.NewRequestWithContext(ctx, http.MethodPost, "/api/v1/search", nil)
+		request.Header.Set("Origin", origin)
+
+		recorder = httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		require.Equal(test, http.StatusOK, recorder.Code)
+		require.Empty(test, recorder.Header().Get("Access-Control-Allow-Origin"))
+	}
+}
+
+func TestBasicHTTPServer_CORSHandler_noPolicy(test *testing.T) {
+	ctx := test.Context()
+	httpServer := getTestObject(ctx, test)
+	handler := httpServer.CORSHandler(http.HandlerFunc(func(response http.ResponseWriter, _ *http.Request) {
+		response.WriteHeader(http.StatusOK)
+	}))
+
+	request := httptest.NewRequestWithContext(ctx, http.MethodPost, "/grpc/szproduct.SzProduct/GetVersion", nil)
+	request.Header.Set("Origin", "https://app.example.com")
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	require.Equal(test, http.StatusForbidden, recorder.Code)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func getCORSTestHandler(ctx context.Context, test *testing.T) http.Handler {
+	test.Helper()
+
+	httpServer := getTestObject(ctx, test)
+	httpServer.CORSPolicy = &httpserver.CORSPolicy{
+		CORSRule: httpserver.CORSRule{
+			AllowCredentials: true,
+			AllowedOrigins:   []string{"https://app.example.com", "https://*.example.net"},
+			ExposedHeaders:   []string{"Grpc-Status", "Grpc-Message"},
+			MaxAge:           10 * time.Minute,
+		},
+		Routes: []httpserver.CORSRoute{
+			{
+				CORSRule: httpserver.CORSRule{
+					AllowedOrigins: []string{"regexp:https://analyst-[0-9]+\\.example\\.com"},
+					MaxAge:         10 * time.Minute,
+				},
+				Paths: []string{"/api/v1/search"},
+			},
+		},
+	}
+
+	return httpServer.CORSHandler(http.HandlerFunc(func(response http.ResponseWriter, _ *http.Request) {
+		_, _ = response.Write([]byte("handled"))
+	}))
+}
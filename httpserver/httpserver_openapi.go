@@ -0,0 +1,463 @@
+package httpserver
+
+import (
+	"context"
+	_ "embed" // Embeds the API explorer.
+	"encoding/json"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A senzingFlag is a named value for the flags of the Senzing calls.
+type senzingFlag struct {
+	name  string
+	value int64
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// OpenAPIPath is where the OpenAPI document of the REST gateway is served.
+const OpenAPIPath = "/openapi.json"
+
+// ExplorerPath is where the API explorer is served.
+const ExplorerPath = "/explorer"
+
+const openAPIVersion = "3.1.0"
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// The API explorer: a page without external dependencies that reads OpenAPIPath.
+//
+//go:embed explorer.html
+var explorerHTML []byte
+
+// Named Senzing flags.  Aliases, such as SzSearchIncludeResolved, are left out.
+var senzingFlags = []senzingFlag{
+	{"SzNoFlags", senzing.SzNoFlags},
+	{"SzExportIncludeMultiRecordEntities", senzing.SzExportIncludeMultiRecordEntities},
+	{"SzExportIncludePossiblySame", senzing.SzExportIncludePossiblySame},
+	{"SzExportIncludePossiblyRelated", senzing.SzExportIncludePossiblyRelated},
+	{"SzExportIncludeNameOnly", senzing.SzExportIncludeNameOnly},
+	{"SzExportIncludeDisclosed", senzing.SzExportIncludeDisclosed},
+	{"SzExportIncludeSingleRecordEntities", senzing.SzExportIncludeSingleRecordEntities},
+	{"SzEntityIncludePossiblySameRelations", senzing.SzEntityIncludePossiblySameRelations},
+	{"SzEntityIncludePossiblyRelatedRelations", senzing.SzEntityIncludePossiblyRelatedRelations},
+	{"SzEntityIncludeNameOnlyRelations", senzing.SzEntityIncludeNameOnlyRelations},
+	{"SzEntityIncludeDisclosedRelations", senzing.SzEntityIncludeDisclosedRelations},
+	{"SzEntityIncludeAllFeatures", senzing.SzEntityIncludeAllFeatures},
+	{"SzEntityIncludeRepresentativeFeatures", senzing.SzEntityIncludeRepresentativeFeatures},
+	{"SzEntityIncludeEntityName", senzing.SzEntityIncludeEntityName},
+	{"SzEntityIncludeRecordSummary", senzing.SzEntityIncludeRecordSummary},
+	{"SzEntityIncludeRecordData", senzing.SzEntityIncludeRecordData},
+	{"SzEntityIncludeRecordMatchingInfo", senzing.SzEntityIncludeRecordMatchingInfo},
+	{"SzEntityIncludeRecordJSONData", senzing.SzEntityIncludeRecordJSONData},
+	{"SzEntityIncludeRecordFeatures", senzing.SzEntityIncludeRecordFeatures},
+	{"SzEntityIncludeRelatedEntityName", senzing.SzEntityIncludeRelatedEntityName},
+	{"SzEntityIncludeRelatedMatchingInfo", senzing.SzEntityIncludeRelatedMatchingInfo},
+	{"SzEntityIncludeRelatedRecordSummary", senzing.SzEntityIncludeRelatedRecordSummary},
+	{"SzEntityIncludeRelatedRecordData", senzing.SzEntityIncludeRelatedRecordData},
+	{"SzEntityIncludeInternalFeatures", senzing.SzEntityIncludeInternalFeatures},
+	{"SzEntityIncludeFeatureStats", senzing.SzEntityIncludeFeatureStats},
+	{"SzFindPathStrictAvoid", senzing.SzFindPathStrictAvoid},
+	{"SzIncludeFeatureScores", senzing.SzIncludeFeatureScores},
+	{"SzSearchIncludeStats", senzing.SzSearchIncludeStats},
+	{"SzEntityIncludeRecordTypes", senzing.SzEntityIncludeRecordTypes},
+	{"SzEntityIncludeRelatedRecordTypes", senzing.SzEntityIncludeRelatedRecordTypes},
+	{"SzFindPathIncludeMatchingInfo", senzing.SzFindPathIncludeMatchingInfo},
+	{"SzEntityIncludeRecordUnmappedData", senzing.SzEntityIncludeRecordUnmappedData},
+	{"SzSearchIncludeAllCandidates", senzing.SzSearchIncludeAllCandidates},
+	{"SzFindNetworkIncludeMatchingInfo", senzing.SzFindNetworkIncludeMatchingInfo},
+	{"SzIncludeMatchKeyDetails", senzing.SzIncludeMatchKeyDetails},
+	{"SzEntityIncludeRecordFeatureDetails", senzing.SzEntityIncludeRecordFeatureDetails},
+	{"SzEntityIncludeRecordFeatureStats", senzing.SzEntityIncludeRecordFeatureStats},
+	{"SzSearchIncludeRequest", senzing.SzSearchIncludeRequest},
+	{"SzSearchIncludeRequestDetails", senzing.SzSearchIncludeRequestDetails},
+	{"SzEntityIncludeRecordDates", senzing.SzEntityIncludeRecordDates},
+	{"SzIncludeFeatureHashes", senzing.SzIncludeFeatureHashes},
+	{"SzWithInfo", senzing.SzWithInfo},
+	{"SzEntityIncludeAllRelations", senzing.SzEntityIncludeAllRelations},
+	{"SzExportIncludeAllEntities", senzing.SzExportIncludeAllEntities},
+	{"SzExportIncludeAllHavingRelationships", senzing.SzExportIncludeAllHavingRelationships},
+	{"SzSearchIncludeAllEntities", senzing.SzSearchIncludeAllEntities},
+	{"SzEntityCoreFlags", senzing.SzEntityCoreFlags},
+	{"SzSearchByAttributesAll", senzing.SzSearchByAttributesAll},
+	{"SzSearchByAttributesMinimalAll", senzing.SzSearchByAttributesMinimalAll},
+	{"SzSearchByAttributesMinimalStrong", senzing.SzSearchByAttributesMinimalStrong},
+	{"SzSearchByAttributesStrong", senzing.SzSearchByAttributesStrong},
+}
+
+var pathWildcard = regexp.MustCompile(`\{(\w+)\}`)
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+The OpenAPIHandler method gets the http.ServeMux serving the OpenAPI 3 document of the
+REST gateway at OpenAPIPath and the API explorer at ExplorerPath.
+
+The document is built on first use from the services registered on GRPCServer, so
+it only describes the routes of enabled services.  Its components include a schema
+for the request and response message of every method of those services, and the
+SzFlag values.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - httpServeMux - the Mux of the document and the explorer.
+*/
+func (httpServer *BasicHTTPServer) OpenAPIHandler(ctx context.Context) *http.ServeMux {
+	_ = ctx
+
+	var (
+		document     []byte
+		documentOnce sync.Once
+	)
+
+	openAPIMux := http.NewServeMux()
+
+	openAPIMux.HandleFunc("GET "+OpenAPIPath, func(response http.ResponseWriter, request *http.Request) {
+		_ = request
+
+		documentOnce.Do(func() {
+			document, _ = json.MarshalIndent(httpServer.openAPIDocument(), "", "  ")
+		})
+
+		response.Header().Set("Content-Type", "application/json")
+		_, _ = response.Write(document)
+	})
+
+	openAPIMux.HandleFunc("GET "+ExplorerPath, func(response http.ResponseWriter, request *http.Request) {
+		_ = request
+
+		response.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = response.Write(explorerHTML)
+	})
+
+	return openAPIMux
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (httpServer *BasicHTTPServer) registerOpenAPI(ctx context.Context, rootMux *http.ServeMux) {
+	if httpServer.EnableAll || httpServer.EnableREST {
+		openAPIMux := httpServer.OpenAPIHandler(ctx)
+		rootMux.Handle(OpenAPIPath, openAPIMux)
+		rootMux.Handle(ExplorerPath, openAPIMux)
+		httpServer.log(
+			2006,
+			httpServer.scheme(),
+			httpServer.ServerPort,
+			OpenAPIPath,
+			httpServer.scheme(),
+			httpServer.ServerPort,
+			ExplorerPath,
+		)
+	}
+}
+
+// Build the OpenAPI document from the services registered on GRPCServer.
+func (httpServer *BasicHTTPServer) openAPIDocument() map[string]any {
+	schemas := map[string]any{
+		"Problem": problemSchema(),
+		"SzFlag":  flagSchema(),
+	}
+	paths := map[string]any{}
+	registered := map[string]bool{}
+	routed := map[string]bool{}
+
+	if httpServer.GRPCServer != nil {
+		for serviceName := range httpServer.GRPCServer.GetServiceInfo() {
+			if strings.HasPrefix(serviceName, "grpc.") {
+				continue
+			}
+
+			descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(serviceName))
+			if err != nil {
+				continue
+			}
+
+			service, isService := descriptor.(protoreflect.ServiceDescriptor)
+			if !isService {
+				continue
+			}
+
+			registered[serviceName] = true
+
+			for index := range service.Methods().Len() {
+				method := service.Methods().Get(index)
+				addMessageSchemas(schemas, method.Input())
+				addMessageSchemas(schemas, method.Output())
+			}
+		}
+	}
+
+	prefix := httpServer.getRESTRoutePrefix()
+
+	for _, route := range restRoutes {
+		serviceName := strings.Split(strings.TrimPrefix(route.fullMethod, "/"), "/")[0]
+		if !registered[serviceName] {
+			continue
+		}
+
+		method, path, _ := strings.Cut(route.pattern, " ")
+
+		pathItem, isKnown := paths[prefix+path].(map[string]any)
+		if !isKnown {
+			pathItem = map[string]any{}
+			paths[prefix+path] = pathItem
+		}
+
+		pathItem[strings.ToLower(method)] = route.openAPIOperation(path)
+		routed[serviceName] = true
+	}
+
+	tags := []any{}
+	for _, serviceName := range slices.Sorted(maps.Keys(routed)) {
+		tags = append(tags, map[string]any{"name": serviceName})
+	}
+
+	return map[string]any{
+		"openapi": openAPIVersion,
+		"info": map[string]any{
+			"title":       "Senzing REST gateway",
+			"description": "JSON over HTTP for the Senzing gRPC services. Senzing results are returned as is; errors are RFC 7807 problem documents.",
+			"version":     strings.TrimPrefix(prefix, "/"),
+		},
+		"paths": paths,
+		"tags":  tags,
+		"components": map[string]any{
+			"schemas": schemas,
+			"securitySchemes": map[string]any{
+				"apiKey": map[string]any{"type": "apiKey", "in": "header", "name": "x-api-key"},
+				"bearer": map[string]any{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+			},
+		},
+		"security": []any{map[string]any{}, map[string]any{"apiKey": []any{}}, map[string]any{"bearer": []any{}}},
+	}
+}
+
+// The OpenAPI operation of route, whose path is below the REST route prefix.
+func (route restRoute) openAPIOperation(path string) map[string]any {
+	serviceName, methodName, _ := strings.Cut(strings.TrimPrefix(route.fullMethod, "/"), "/")
+	request := route.newRequest().ProtoReflect().Descriptor()
+	response := route.newResponse().ProtoReflect().Descriptor()
+	parameters := []any{}
+	inPath := map[string]bool{}
+
+	for _, match := range pathWildcard.FindAllStringSubmatch(path, -1) {
+		inPath[match[1]] = true
+		parameters = append(parameters, map[string]any{
+			"name":     match[1],
+			"in":       "path",
+			"required": true,
+			"schema":   fieldSchema(request.Fields().ByName(protoreflect.Name(match[1]))),
+		})
+	}
+
+	for index := range request.Fields().Len() {
+		field := request.Fields().Get(index)
+		name := string(field.Name())
+
+		if inPath[name] || name == route.bodyField {
+			continue
+		}
+
+		schema := fieldSchema(field)
+		if name == "flags" {
+			schema["default"] = route.defaultFlags
+		}
+
+		parameters = append(parameters, map[string]any{"name": name, "in": "query", "schema": schema})
+	}
+
+	operation := map[string]any{
+		"operationId": serviceName[strings.LastIndex(serviceName, ".")+1:] + "_" + methodName,
+		"summary":     methodName,
+		"tags":        []any{serviceName},
+		"parameters":  parameters,
+		"responses": map[string]any{
+			"200": route.openAPIResult(response),
+			"204": map[string]any{"description": "The result is empty."},
+			"default": map[string]any{
+				"description": "An error.",
+				"content": map[string]any{
+					"application/problem+json": map[string]any{"schema": map[string]any{"$ref": "#/components/schemas/Problem"}},
+				},
+			},
+		},
+		"x-grpc-method":   route.fullMethod,
+		"x-grpc-request":  map[string]any{"$ref": schemaRef(request)},
+		"x-grpc-response": map[string]any{"$ref": schemaRef(response)},
+	}
+
+	if len(route.bodyField) > 0 {
+		operation["requestBody"] = map[string]any{
+			"description": "The " + route.bodyField + " of " + string(request.FullName()) + ", a JSON document.",
+			"required":    true,
+			"content":     map[string]any{"application/json": map[string]any{"schema": map[string]any{"type": "object"}}},
+		}
+	}
+
+	return operation
+}
+
+// The OpenAPI response of a successful call.
+func (route restRoute) openAPIResult(response protoreflect.MessageDescriptor) map[string]any {
+	responseField := route.responseField
+	if len(responseField) == 0 {
+		responseField = "result"
+	}
+
+	field := response.Fields().ByName(protoreflect.Name(responseField))
+
+	schema := map[string]any{"description": "Senzing JSON."}
+	if field != nil && field.Kind() != protoreflect.StringKind {
+		schema = fieldSchema(field)
+	}
+
+	return map[string]any{
+		"description": "The " + responseField + " of " + string(response.FullName()) + ".",
+		"content":     map[string]any{"application/json": map[string]any{"schema": schema}},
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Add the schema of message, and of the messages it uses, to schemas.
+func addMessageSchemas(schemas map[string]any, message protoreflect.MessageDescriptor) {
+	name := string(message.FullName())
+	if _, isKnown := schemas[name]; isKnown {
+		return
+	}
+
+	properties := map[string]any{}
+	schema := map[string]any{"type": "object", "properties": properties}
+	schemas[name] = schema
+
+	for index := range message.Fields().Len() {
+		field := message.Fields().Get(index)
+		properties[field.JSONName()] = fieldSchema(field)
+
+		if field.Message() != nil && !field.IsMap() {
+			addMessageSchemas(schemas, field.Message())
+		}
+
+		if field.IsMap() && field.MapValue().Message() != nil {
+			addMessageSchemas(schemas, field.MapValue().Message())
+		}
+	}
+}
+
+// The JSON schema of a field, as encoded by protojson.
+func fieldSchema(field protoreflect.FieldDescriptor) map[string]any {
+	if field.IsMap() {
+		return map[string]any{"type": "object", "additionalProperties": singularFieldSchema(field.MapValue())}
+	}
+
+	if field.IsList() {
+		return map[string]any{"type": "array", "items": singularFieldSchema(field)}
+	}
+
+	return singularFieldSchema(field)
+}
+
+func singularFieldSchema(field protoreflect.FieldDescriptor) map[string]any {
+	switch field.Kind() { //nolint:exhaustive // Groups are not used.
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if field.Name() == "flags" {
+			return map[string]any{
+				"type":            "integer",
+				"format":          "int64",
+				"description":     "Bitwise OR of SzFlag values.",
+				"x-senzing-flags": map[string]any{"$ref": "#/components/schemas/SzFlag"},
+			}
+		}
+
+		return map[string]any{"type": "integer", "format": "int64"}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return map[string]any{"type": "number"}
+	case protoreflect.BytesKind:
+		return map[string]any{"type": "string", "contentEncoding": "base64"}
+	case protoreflect.EnumKind:
+		values := []any{}
+		for index := range field.Enum().Values().Len() {
+			values = append(values, string(field.Enum().Values().Get(index).Name()))
+		}
+
+		return map[string]any{"type": "string", "enum": values}
+	case protoreflect.MessageKind:
+		return map[string]any{"$ref": schemaRef(field.Message())}
+	default:
+		return map[string]any{"type": "string"}
+	}
+}
+
+func schemaRef(message protoreflect.MessageDescriptor) string {
+	return "#/components/schemas/" + string(message.FullName())
+}
+
+// The SzFlag values, named as in github.com/senzing-garage/sz-sdk-go/senzing.
+func flagSchema() map[string]any {
+	names := make([]any, 0, len(senzingFlags))
+	values := make([]any, 0, len(senzingFlags))
+
+	for _, flag := range senzingFlags {
+		names = append(names, flag.name)
+		values = append(values, flag.value)
+	}
+
+	return map[string]any{
+		"type":            "integer",
+		"format":          "int64",
+		"description":     "Senzing flags. The flags of a call are the bitwise OR of these values.",
+		"enum":            values,
+		"x-enum-varnames": names,
+	}
+}
+
+func problemSchema() map[string]any {
+	stringSchema := map[string]any{"type": "string"}
+
+	return map[string]any{
+		"type":        "object",
+		"description": "An RFC 7807 problem document.",
+		"properties": map[string]any{
+			"code":     map[string]any{"type": "string", "description": "gRPC status code name."},
+			"detail":   stringSchema,
+			"instance": stringSchema,
+			"metadata": map[string]any{"type": "object", "additionalProperties": stringSchema},
+			"reason":   map[string]any{"type": "string", "description": "google.rpc.ErrorInfo reason, such as a Senzing error."},
+			"status":   map[string]any{"type": "integer"},
+			"title":    stringSchema,
+			"type":     stringSchema,
+		},
+	}
+}
@@ -0,0 +1,89 @@
+package httpserver_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/senzing-garage/serve-grpc/httpserver"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	"github.com/senzing-garage/sz-sdk-proto/go/szproduct"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface methods
+// ----------------------------------------------------------------------------
+
+func TestBasicHTTPServer_OpenAPIHandler(test *testing.T) {
+	ctx := test.Context()
+
+	grpcServer := grpc.NewServer()
+	szengine.RegisterSzEngineServer(grpcServer, &restTestSzEngine{})
+	szproduct.RegisterSzProductServer(grpcServer, &restTestSzProduct{})
+
+	httpServer := getTestObject(ctx, test)
+	httpServer.GRPCServer = grpcServer
+	handler := httpServer.OpenAPIHandler(ctx)
+
+	recorder := serveREST(ctx, handler, http.MethodGet, httpserver.OpenAPIPath, "")
+	require.Equal(test, http.StatusOK, recorder.Code)
+	require.Equal(test, "application/json", recorder.Header().Get("Content-Type"))
+
+	var document struct {
+		OpenAPI string `json:"openapi"`
+		Paths   map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+			GRPCMethod  string `json:"x-grpc-method"`
+			Parameters  []struct {
+				In     string         `json:"in"`
+				Name   string         `json:"name"`
+				Schema map[string]any `json:"schema"`
+			} `json:"parameters"`
+			RequestBody map[string]any `json:"requestBody"`
+		} `json:"paths"`
+		Components struct {
+			Schemas map[string]map[string]any `json:"schemas"`
+		} `json:"components"`
+	}
+
+	err := json.Unmarshal(recorder.Body.Bytes(), &document)
+	require.NoError(test, err)
+	require.True(test, strings.HasPrefix(document.OpenAPI, "3."))
+
+	// Routes of registered services are described.
+
+	getEntity := document.Paths["/api/v1/entities/{entity_id}"]["get"]
+	require.Equal(test, "SzEngine_GetEntityByEntityId", getEntity.OperationID)
+	require.Equal(test, szengine.SzEngine_GetEntityByEntityId_FullMethodName, getEntity.GRPCMethod)
+	require.Len(test, getEntity.Parameters, 2)
+	require.Equal(test, "path", getEntity.Parameters[0].In)
+	require.Equal(test, "entity_id", getEntity.Parameters[0].Name)
+	require.Equal(test, "flags", getEntity.Parameters[1].Name)
+	require.InDelta(test, float64(senzing.SzEntityDefaultFlags), getEntity.Parameters[1].Schema["default"], 0)
+
+	require.NotNil(test, document.Paths["/api/v1/search"]["post"].RequestBody)
+	require.Contains(test, document.Paths, "/api/v1/product/version")
+
+	// Routes of services that are not registered are not.
+
+	require.NotContains(test, document.Paths, "/api/v1/config/registry")
+
+	// Components describe the messages and the flags.
+
+	require.Contains(test, document.Components.Schemas, "szengine.GetEntityByEntityIdRequest")
+	require.Contains(test, document.Components.Schemas, "szproduct.GetVersionResponse")
+	require.Contains(test, document.Components.Schemas, "Problem")
+	require.Contains(test, document.Components.Schemas["SzFlag"]["x-enum-varnames"], "SzEntityIncludeEntityName")
+
+	// The explorer is a page of its own.
+
+	recorder = serveREST(ctx, handler, http.MethodGet, httpserver.ExplorerPath, "")
+	require.Equal(test, http.StatusOK, recorder.Code)
+	require.Contains(test, recorder.Header().Get("Content-Type"), "text/html")
+	require.Contains(test, recorder.Body.String(), "openapi.json")
+	require.NotContains(test, recorder.Body.String(), "https://")
+}
@@ -0,0 +1,710 @@
+package httpserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-proto/go/szconfig"
+	"github.com/senzing-garage/sz-sdk-proto/go/szconfigmanager"
+	"github.com/senzing-garage/sz-sdk-proto/go/szdiagnostic"
+	"github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	"github.com/senzing-garage/sz-sdk-proto/go/szproduct"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+A restRoute maps an HTTP method and path onto a unary gRPC method.
+
+The request message is filled from the path wildcards and query parameters that
+are named like its fields, for example {entity_id} or ?search_profile=, and from
+the request body if bodyField is set.  The response body is the responseField of
+the response message, which is "result" unless set.
+*/
+type restRoute struct {
+	bodyField     string
+	defaultFlags  int64
+	fullMethod    string
+	newRequest    func() proto.Message
+	newResponse   func() proto.Message
+	pattern       string // http.ServeMux pattern below RESTRoutePrefix, e.g. "GET /entities/{entity_id}".
+	responseField string
+}
+
+// restResponseWriter collects the response of grpc.Server.ServeHTTP for one unary call.
+type restResponseWriter struct {
+	body   bytes.Buffer
+	header http.Header
+}
+
+// A problem is an RFC 7807 problem document.
+type problem struct {
+	Code     string            `json:"code,omitempty"` // gRPC status code name.
+	Detail   string            `json:"detail,omitempty"`
+	Instance string            `json:"instance,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"` // google.rpc.ErrorInfo metadata.
+	Reason   string            `json:"reason,omitempty"`   // google.rpc.ErrorInfo reason.
+	Status   int               `json:"status"`
+	Title    string            `json:"title"`
+	Type     string            `json:"type"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// DefaultRESTRoutePrefix is where the REST routes are served when RESTRoutePrefix is not set.
+const DefaultRESTRoutePrefix = "/api/v1"
+
+// Bytes allowed in a REST request body.
+const restMaxBodyBytes = 64 << 20
+
+// Bytes of the length-prefixed message framing used by gRPC over HTTP/2.
+const grpcFrameHeaderSize = 5
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+/*
+The REST routes.  Calls that destroy data in bulk, such as PurgeRepository, are only
+available over gRPC.
+*/
+var restRoutes = []restRoute{
+	// SzEngine.
+
+	{
+		pattern:      "GET /entities/{entity_id}",
+		fullMethod:   szengine.SzEngine_GetEntityByEntityId_FullMethodName,
+		newRequest:   func() proto.Message { return &szengine.GetEntityByEntityIdRequest{} },
+		newResponse:  func() proto.Message { return &szengine.GetEntityByEntityIdResponse{} },
+		defaultFlags: senzing.SzEntityDefaultFlags,
+	},
+	{
+		pattern:      "GET /entities/{entity_id}/how",
+		fullMethod:   szengine.SzEngine_HowEntityByEntityId_FullMethodName,
+		newRequest:   func() proto.Message { return &szengine.HowEntityByEntityIdRequest{} },
+		newResponse:  func() proto.Message { return &szengine.HowEntityByEntityIdResponse{} },
+		defaultFlags: senzing.SzHowEntityDefaultFlags,
+	},
+	{
+		pattern:      "GET /entities/{entity_id}/interesting",
+		fullMethod:   szengine.SzEngine_FindInterestingEntitiesByEntityId_FullMethodName,
+		newRequest:   func() proto.Message { return &szengine.FindInterestingEntitiesByEntityIdRequest{} },
+		newResponse:  func() proto.Message { return &szengine.FindInterestingEntitiesByEntityIdResponse{} },
+		defaultFlags: senzing.SzFindInterestingEntitiesDefaultFlags,
+	},
+	{
+		pattern:      "POST /entities/{entity_id}/reevaluate",
+		fullMethod:   szengine.SzEngine_ReevaluateEntity_FullMethodName,
+		newRequest:   func() proto.Message { return &szengine.ReevaluateEntityRequest{} },
+		newResponse:  func() proto.Message { return &szengine.ReevaluateEntityResponse{} },
+		defaultFlags: senzing.SzReevaluateEntityDefaultFlags,
+	},
+	{
+		pattern:      "GET /entities/{entity_id_1}/why/{entity_id_2}",
+		fullMethod:   szengine.SzEngine_WhyEntities_FullMethodName,
+		newRequest:   func() proto.Message { return &szengine.WhyEntitiesRequest{} },
+		newResponse:  func() proto.Message { return &szengine.WhyEntitiesResponse{} },
+		defaultFlags: senzing.SzWhyEntitiesDefaultFlags,
+	},
+	{
+		pattern:      "GET /network",
+		fullMethod:   szengine.SzEngine_FindNetworkByEntityId_FullMethodName,
+		newRequest:   func() proto.Message { return &szengine.FindNetworkByEntityIdRequest{} },
+		newResponse:  func() proto.Message { return &szengine.FindNetworkByEntityIdResponse{} },
+		defaultFlags: senzing.SzFindNetworkDefaultFlags,
+	},
+	{
+		pattern:      "GET /paths/{start_entity_id}/{end_entity_id}",
+		fullMethod:   szengine.SzEngine_FindPathByEntityId_FullMethodName,
+		newRequest:   func() proto.Message { return &szengine.FindPathByEntityIdRequest{} },
+		newResponse:  func() proto.Message { return &szengine.FindPathByEntityIdResponse{} },
+		defaultFlags: senzing.SzFindPathDefaultFlags,
+	},
+	{
+		pattern:      "GET /records/{data_source_code}/{record_id}",
+		fullMethod:   szengine.SzEngine_GetRecord_FullMethodName,
+		newRequest:   func() proto.Message { return &szengine.GetRecordRequest{} },
+		newResponse:  func() proto.Message { return &szengine.GetRecordResponse{} },
+		defaultFlags: senzing.SzRecordDefaultFlags,
+	},
+	{
+		pattern:      "POST /records/{data_source_code}/{record_id}",
+		fullMethod:   szengine.SzEngine_AddRecord_FullMethodName,
+		newRequest:   func() proto.Message { return &szengine.AddRecordRequest{} },
+		newResponse:  func() proto.Message { return &szengine.AddRecordResponse{} },
+		bodyField:    "record_definition",
+		defaultFlags: senzing.SzAddRecordDefaultFlags,
+	},
+	{
+		pattern:      "DELETE /records/{data_source_code}/{record_id}",
+		fullMethod:   szengine.SzEngine_DeleteRecord_FullMethodName,
+		newRequest:   func() proto.Message { return &szengine.DeleteRecordRequest{} },
+		newResponse:  func() proto.Message { return &szengine.DeleteRecordResponse{} },
+		defaultFlags: senzing.SzDeleteRecordDefaultFlags,
+	},
+	{
+		pattern:      "GET /records/{data_source_code}/{record_id}/entity",
+		fullMethod:   szengine.SzEngine_GetEntityByRecordId_FullMethodName,
+		newRequest:   func() proto.Message { return &szengine.GetEntityByRecordIdRequest{} },
+		newResponse:  func() proto.Message { return &szengine.GetEntityByRecordIdResponse{} },
+		defaultFlags: senzing.SzEntityDefaultFlags,
+	},
+	{
+		pattern:      "POST /records/{data_source_code}/{record_id}/reevaluate",
+		fullMethod:   szengine.SzEngine_ReevaluateRecord_FullMethodName,
+		newRequest:   func() proto.Message { return &szengine.ReevaluateRecordRequest{} },
+		newResponse:  func() proto.Message { return &szengine.ReevaluateRecordResponse{} },
+		defaultFlags: senzing.SzReevaluateRecordDefaultFlags,
+	},
+	{
+		pattern:      "GET /records/{data_source_code}/{record_id}/why",
+		fullMethod:   szengine.SzEngine_WhyRecordInEntity_FullMethodName,
+		newRequest:   func() proto.Message { return &szengine.WhyRecordInEntityRequest{} },
+		newResponse:  func() proto.Message { return &szengine.WhyRecordInEntityResponse{} },
+		defaultFlags: senzing.SzWhyRecordInEntityDefaultFlags,
+	},
+	{
+		pattern:      "POST /record-preview",
+		fullMethod:   szengine.SzEngine_GetRecordPreview_FullMethodName,
+		newRequest:   func() proto.Message { return &szengine.GetRecordPreviewRequest{} },
+		newResponse:  func() proto.Message { return &szengine.GetRecordPreviewResponse{} },
+		bodyField:    "record_definition",
+		defaultFlags: senzing.SzRecordPreviewDefaultFlags,
+	},
+	{
+		pattern:     "GET /redo-records/count",
+		fullMethod:  szengine.SzEngine_CountRedoRecords_FullMethodName,
+		newRequest:  func() proto.Message { return &szengine.CountRedoRecordsRequest{} },
+		newResponse: func() proto.Message { return &szengine.CountRedoRecordsResponse{} },
+	},
+	{
+		pattern:      "POST /search",
+		fullMethod:   szengine.SzEngine_SearchByAttributes_FullMethodName,
+		newRequest:   func() proto.Message { return &szengine.SearchByAttributesRequest{} },
+		newResponse:  func() proto.Message { return &szengine.SearchByAttributesResponse{} },
+		bodyField:    "attributes",
+		defaultFlags: senzing.SzSearchByAttributesDefaultFlags,
+	},
+	{
+		pattern:     "GET /stats",
+		fullMethod:  szengine.SzEngine_GetStats_FullMethodName,
+		newRequest:  func() proto.Message { return &szengine.GetStatsRequest{} },
+		newResponse: func() proto.Message { return &szengine.GetStatsResponse{} },
+	},
+
+	// SzConfigManager.  GET /config/active-id is answered by SzEngine.
+
+	{
+		pattern:     "GET /config/active-id",
+		fullMethod:  szengine.SzEngine_GetActiveConfigId_FullMethodName,
+		newRequest:  func() proto.Message { return &szengine.GetActiveConfigIdRequest{} },
+		newResponse: func() proto.Message { return &szengine.GetActiveConfigIdResponse{} },
+	},
+	{
+		pattern:     "GET /config/default-id",
+		fullMethod:  szconfigmanager.SzConfigManager_GetDefaultConfigId_FullMethodName,
+		newRequest:  func() proto.Message { return &szconfigmanager.GetDefaultConfigIdRequest{} },
+		newResponse: func() proto.Message { return &szconfigmanager.GetDefaultConfigIdResponse{} },
+	},
+	{
+		pattern:     "PUT /config/default-id/{config_id}",
+		fullMethod:  szconfigmanager.SzConfigManager_SetDefaultConfigId_FullMethodName,
+		newRequest:  func() proto.Message { return &szconfigmanager.SetDefaultConfigIdRequest{} },
+		newResponse: func() proto.Message { return &szconfigmanager.SetDefaultConfigIdResponse{} },
+	},
+	{
+		pattern:     "GET /config/registry",
+		fullMethod:  szconfigmanager.SzConfigManager_GetConfigRegistry_FullMethodName,
+		newRequest:  func() proto.Message { return &szconfigmanager.GetConfigRegistryRequest{} },
+		newResponse: func() proto.Message { return &szconfigmanager.GetConfigRegistryResponse{} },
+	},
+	{
+		pattern:     "GET /config/template",
+		fullMethod:  szconfigmanager.SzConfigManager_GetTemplateConfig_FullMethodName,
+		newRequest:  func() proto.Message { return &szconfigmanager.GetTemplateConfigRequest{} },
+		newResponse: func() proto.Message { return &szconfigmanager.GetTemplateConfigResponse{} },
+	},
+	{
+		pattern:     "GET /configs/{config_id}",
+		fullMethod:  szconfigmanager.SzConfigManager_GetConfig_FullMethodName,
+		newRequest:  func() proto.Message { return &szconfigmanager.GetConfigRequest{} },
+		newResponse: func() proto.Message { return &szconfigmanager.GetConfigResponse{} },
+	},
+	{
+		pattern:     "POST /configs",
+		fullMethod:  szconfigmanager.SzConfigManager_RegisterConfig_FullMethodName,
+		newRequest:  func() proto.Message { return &szconfigmanager.RegisterConfigRequest{} },
+		newResponse: func() proto.Message { return &szconfigmanager.RegisterConfigResponse{} },
+		bodyField:   "config_definition",
+	},
+
+	// SzConfig.  These transform the configuration sent as the request body.
+
+	{
+		pattern:     "POST /config/definition/data-sources",
+		fullMethod:  szconfig.SzConfig_GetDataSourceRegistry_FullMethodName,
+		newRequest:  func() proto.Message { return &szconfig.GetDataSourceRegistryRequest{} },
+		newResponse: func() proto.Message { return &szconfig.GetDataSourceRegistryResponse{} },
+		bodyField:   "config_definition",
+	},
+	{
+		pattern:       "POST /config/definition/data-sources/{data_source_code}",
+		fullMethod:    szconfig.SzConfig_RegisterDataSource_FullMethodName,
+		newRequest:    func() proto.Message { return &szconfig.RegisterDataSourceRequest{} },
+		newResponse:   func() proto.Message { return &szconfig.RegisterDataSourceResponse{} },
+		bodyField:     "config_definition",
+		responseField: "config_definition",
+	},
+	{
+		pattern:       "POST /config/definition/data-sources/{data_source_code}/unregister",
+		fullMethod:    szconfig.SzConfig_UnregisterDataSource_FullMethodName,
+		newRequest:    func() proto.Message { return &szconfig.UnregisterDataSourceRequest{} },
+		newResponse:   func() proto.Message { return &szconfig.UnregisterDataSourceResponse{} },
+		bodyField:     "config_definition",
+		responseField: "config_definition",
+	},
+	{
+		pattern:     "POST /config/definition/verify",
+		fullMethod:  szconfig.SzConfig_VerifyConfig_FullMethodName,
+		newRequest:  func() proto.Message { return &szconfig.VerifyConfigRequest{} },
+		newResponse: func() proto.Message { return &szconfig.VerifyConfigResponse{} },
+		bodyField:   "config_definition",
+	},
+
+	// SzDiagnostic.
+
+	{
+		pattern:     "POST /diagnostic/check-performance",
+		fullMethod:  szdiagnostic.SzDiagnostic_CheckRepositoryPerformance_FullMethodName,
+		newRequest:  func() proto.Message { return &szdiagnostic.CheckRepositoryPerformanceRequest{} },
+		newResponse: func() proto.Message { return &szdiagnostic.CheckRepositoryPerformanceResponse{} },
+	},
+	{
+		pattern:     "GET /diagnostic/features/{feature_id}",
+		fullMethod:  szdiagnostic.SzDiagnostic_GetFeature_FullMethodName,
+		newRequest:  func() proto.Message { return &szdiagnostic.GetFeatureRequest{} },
+		newResponse: func() proto.Message { return &szdiagnostic.GetFeatureResponse{} },
+	},
+	{
+		pattern:     "GET /diagnostic/repository-info",
+		fullMethod:  szdiagnostic.SzDiagnostic_GetRepositoryInfo_FullMethodName,
+		newRequest:  func() proto.Message { return &szdiagnostic.GetRepositoryInfoRequest{} },
+		newResponse: func() proto.Message { return &szdiagnostic.GetRepositoryInfoResponse{} },
+	},
+
+	// SzProduct.
+
+	{
+		pattern:     "GET /product/license",
+		fullMethod:  szproduct.SzProduct_GetLicense_FullMethodName,
+		newRequest:  func() proto.Message { return &szproduct.GetLicenseRequest{} },
+		newResponse: func() proto.Message { return &szproduct.GetLicenseResponse{} },
+	},
+	{
+		pattern:     "GET /product/version",
+		fullMethod:  szproduct.SzProduct_GetVersion_FullMethodName,
+		newRequest:  func() proto.Message { return &szproduct.GetVersionRequest{} },
+		newResponse: func() proto.Message { return &szproduct.GetVersionResponse{} },
+	},
+}
+
+// HTTP status of each gRPC status code, as in google/api/http.proto.
+var httpStatusFromCode = map[codes.Code]int{
+	codes.OK:                 http.StatusOK,
+	codes.Canceled:           499, //nolint:mnd // Client Closed Request has no net/http constant.
+	codes.Unknown:            http.StatusInternalServerError,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.FailedPrecondition: http.StatusBadRequest,
+	codes.Aborted:            http.StatusConflict,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Internal:           http.StatusInternalServerError,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DataLoss:           http.StatusInternalServerError,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+The RESTHandler method gets the http.ServeMux for the REST gateway, which serves the
+routes below RESTRoutePrefix.  Each call goes through the gRPC server, so its
+authentication, access policy, read-only mode, rate limits, timeouts, metrics and
+tracing apply as they do to gRPC and grpc-web calls.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - httpServeMux - the Mux of the REST gateway.
+*/
+func (httpServer *BasicHTTPServer) RESTHandler(ctx context.Context) *http.ServeMux {
+	_ = ctx
+
+	prefix := httpServer.getRESTRoutePrefix()
+	restMux := http.NewServeMux()
+
+	for _, route := range restRoutes {
+		method, path, _ := strings.Cut(route.pattern, " ")
+		restMux.HandleFunc(method+" "+prefix+path, httpServer.restHandler(route))
+	}
+
+	restMux.HandleFunc(prefix+"/", func(response http.ResponseWriter, request *http.Request) {
+		writeProblem(response, request, status.New(codes.NotFound, "no REST route for "+request.URL.Path))
+	})
+
+	return restMux
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods for http.ResponseWriter and http.Flusher
+// ----------------------------------------------------------------------------
+
+func (writer *restResponseWriter) Header() http.Header {
+	return writer.header
+}
+
+func (writer *restResponseWriter) Write(buffer []byte) (int, error) {
+	return writer.body.Write(buffer) //nolint:wrapcheck // bytes.Buffer never fails.
+}
+
+func (writer *restResponseWriter) WriteHeader(statusCode int) {
+	_ = statusCode
+}
+
+func (writer *restResponseWriter) Flush() {}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (httpServer *BasicHTTPServer) registerREST(ctx context.Context, rootMux *http.ServeMux) {
+	if httpServer.EnableAll || httpServer.EnableREST {
+		prefix := httpServer.getRESTRoutePrefix()
+		rootMux.Handle(prefix+"/", httpServer.RESTHandler(ctx))
+		httpServer.log(2005, httpServer.scheme(), httpServer.ServerPort, prefix)
+	}
+}
+
+func (httpServer *BasicHTTPServer) getRESTRoutePrefix() string {
+	if len(httpServer.RESTRoutePrefix) > 0 {
+		return "/" + strings.Trim(httpServer.RESTRoutePrefix, "/")
+	}
+
+	return DefaultRESTRoutePrefix
+}
+
+// Transcode a REST request into route's gRPC method and its response back.
+func (httpServer *BasicHTTPServer) restHandler(route restRoute) http.HandlerFunc {
+	return func(response http.ResponseWriter, request *http.Request) {
+		httpServer.log(1003, request.Method, request.URL.Path)
+
+		grpcRequest, err := route.bind(request)
+		if err != nil {
+			writeProblem(response, request, status.New(codes.InvalidArgument, err.Error()))
+
+			return
+		}
+
+		grpcResponse := route.newResponse()
+
+		callStatus := httpServer.invoke(request, route.fullMethod, grpcRequest, grpcResponse)
+		if callStatus.Code() != codes.OK {
+			writeProblem(response, request, callStatus)
+
+			return
+		}
+
+		body, err := route.responseBody(grpcResponse)
+		if err != nil {
+			writeProblem(response, request, status.New(codes.Internal, err.Error()))
+
+			return
+		}
+
+		if len(body) == 0 {
+			response.WriteHeader(http.StatusNoContent)
+
+			return
+		}
+
+		response.Header().Set("Content-Type", "application/json")
+		_, _ = response.Write(body)
+	}
+}
+
+/*
+Call fullMethod on the gRPC server in-process, as grpc-web does.  The headers of
+request, such as authorization and traceparent, become the call's metadata, and its
+remote address and TLS state become the call's peer.
+*/
+func (httpServer *BasicHTTPServer) invoke(
+	request *http.Request,
+	fullMethod string,
+	grpcRequest proto.Message,
+	grpcResponse proto.Message,
+) *status.Status {
+	grpcHTTPRequest, err := newGRPCHTTPRequest(request, fullMethod, grpcRequest)
+	if err != nil {
+		return status.New(codes.Internal, err.Error())
+	}
+
+	writer := &restResponseWriter{header: http.Header{}}
+	httpServer.GRPCServer.ServeHTTP(writer, grpcHTTPRequest)
+
+	callStatus := writer.status()
+	if callStatus.Code() != codes.OK {
+		return callStatus
+	}
+
+	body := writer.body.Bytes()
+	if len(body) < grpcFrameHeaderSize {
+		return status.New(codes.Internal, "the gRPC response has no message")
+	}
+
+	err = proto.Unmarshal(body[grpcFrameHeaderSize:], grpcResponse)
+	if err != nil {
+		return status.New(codes.Internal, err.Error())
+	}
+
+	return callStatus
+}
+
+// Build the gRPC request of route from the path, query and body of request.
+func (route restRoute) bind(request *http.Request) (proto.Message, error) {
+	result := route.newRequest()
+	message := result.ProtoReflect()
+	fields := message.Descriptor().Fields()
+	query := request.URL.Query()
+
+	if flags := fields.ByName("flags"); flags != nil {
+		message.Set(flags, protoreflect.ValueOfInt64(route.defaultFlags))
+	}
+
+	for index := range fields.Len() {
+		field := fields.Get(index)
+		name := string(field.Name())
+
+		text := request.PathValue(name)
+		if len(text) == 0 && query.Has(name) {
+			text = query.Get(name)
+		} else if len(text) == 0 && query.Has(field.JSONName()) {
+			text = query.Get(field.JSONName())
+		} else if len(text) == 0 {
+			continue
+		}
+
+		value, err := parseFieldValue(field, text)
+		if err != nil {
+			return nil, err
+		}
+
+		message.Set(field, value)
+	}
+
+	if len(route.bodyField) > 0 {
+		body, err := io.ReadAll(http.MaxBytesReader(nil, request.Body, restMaxBodyBytes))
+		if err != nil {
+			return nil, err //nolint:wrapcheck // Reported to the client as is.
+		}
+
+		message.Set(fields.ByName(protoreflect.Name(route.bodyField)), protoreflect.ValueOfString(string(body)))
+	}
+
+	return result, nil
+}
+
+/*
+The response body: the response field itself if it is JSON, such as the Senzing
+results, or else the field encoded as JSON, such as a config id.  Empty results,
+and responses without fields, have no body.
+*/
+func (route restRoute) responseBody(grpcResponse proto.Message) ([]byte, error) {
+	message := grpcResponse.ProtoReflect()
+
+	responseField := route.responseField
+	if len(responseField) == 0 {
+		responseField = "result"
+	}
+
+	field := message.Descriptor().Fields().ByName(protoreflect.Name(responseField))
+	if field == nil {
+		return nil, nil
+	}
+
+	value := message.Get(field)
+
+	if field.Kind() == protoreflect.StringKind {
+		text := []byte(value.String())
+		if len(text) == 0 || json.Valid(text) {
+			return text, nil
+		}
+	}
+
+	result, err := json.Marshal(value.Interface())
+
+	return result, err //nolint:wrapcheck // Reported to the client as is.
+}
+
+// The gRPC status in the trailers written by grpc.Server.ServeHTTP.
+func (writer *restResponseWriter) status() *status.Status {
+	if details := writer.header.Get("Grpc-Status-Details-Bin"); len(details) > 0 {
+		encoded, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(details, "="))
+		if err == nil {
+			statusProto := &spb.Status{}
+			if proto.Unmarshal(encoded, statusProto) == nil {
+				return status.FromProto(statusProto)
+			}
+		}
+	}
+
+	code, err := strconv.Atoi(writer.header.Get("Grpc-Status"))
+	if err != nil {
+		return status.New(codes.Internal, "the gRPC response has no status")
+	}
+
+	message, err := url.PathUnescape(writer.header.Get("Grpc-Message"))
+	if err != nil {
+		message = writer.header.Get("Grpc-Message")
+	}
+
+	return status.New(codes.Code(code), message) //nolint:gosec // gRPC codes are small.
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Build the HTTP/2 request of grpc.Server.ServeHTTP that carries grpcRequest to fullMethod.
+func newGRPCHTTPRequest(request *http.Request, fullMethod string, grpcRequest proto.Message) (*http.Request, error) {
+	message, err := proto.Marshal(grpcRequest)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Reported to the client as is.
+	}
+
+	frame := make([]byte, grpcFrameHeaderSize, grpcFrameHeaderSize+len(message))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(message))) //nolint:gosec // Bounded by restMaxBodyBytes.
+	frame = append(frame, message...)
+
+	result := request.Clone(request.Context())
+	result.Method = http.MethodPost
+	result.URL = &url.URL{Path: fullMethod}
+	result.RequestURI = fullMethod
+	result.ProtoMajor = 2
+	result.ProtoMinor = 0
+	result.Body = io.NopCloser(bytes.NewReader(frame))
+	result.ContentLength = int64(len(frame))
+
+	// The headers of the connection, and of a WebSocket handshake, are not metadata of the call.
+
+	for name := range result.Header {
+		if strings.HasPrefix(name, "Sec-Websocket-") {
+			result.Header.Del(name)
+		}
+	}
+
+	result.Header.Del("Connection")
+	result.Header.Del("Content-Length")
+	result.Header.Del("Upgrade")
+	result.Header.Set("Content-Type", "application/grpc+proto")
+
+	return result, nil
+}
+
+/*
+The RFC 7807 problem document of callStatus, and the seconds to wait before
+retrying, if known.  The reason and metadata of a google.rpc.ErrorInfo detail, such
+as a Senzing error code, are included.
+*/
+func newProblem(callStatus *status.Status, instance string) (problem, int) {
+	httpStatus, isKnown := httpStatusFromCode[callStatus.Code()]
+	if !isKnown {
+		httpStatus = http.StatusInternalServerError
+	}
+
+	result := problem{
+		Code:     callStatus.Code().String(),
+		Detail:   callStatus.Message(),
+		Instance: instance,
+		Status:   httpStatus,
+		Title:    http.StatusText(httpStatus),
+		Type:     "about:blank",
+	}
+	retryAfter := 0
+
+	for _, detail := range callStatus.Details() {
+		switch typedDetail := detail.(type) {
+		case *errdetails.ErrorInfo:
+			result.Reason = typedDetail.GetReason()
+			result.Metadata = typedDetail.GetMetadata()
+		case *errdetails.RetryInfo:
+			retryAfter = max(1, int(typedDetail.GetRetryDelay().AsDuration().Seconds()))
+		}
+	}
+
+	return result, retryAfter
+}
+
+func parseFieldValue(field protoreflect.FieldDescriptor, text string) (protoreflect.Value, error) {
+	switch field.Kind() { //nolint:exhaustive // The Senzing requests only use these kinds.
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(text), nil
+	case protoreflect.Int64Kind:
+		value, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return protoreflect.Value{}, errors.New(string(field.Name()) + " must be an integer: " + text) //nolint:err113
+		}
+
+		return protoreflect.ValueOfInt64(value), nil
+	case protoreflect.Int32Kind:
+		value, err := strconv.ParseInt(text, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, errors.New(string(field.Name()) + " must be an integer: " + text) //nolint:err113
+		}
+
+		return protoreflect.ValueOfInt32(int32(value)), nil
+	default:
+		return protoreflect.Value{}, errors.New(string(field.Name()) + " cannot be set from a URL") //nolint:err113
+	}
+}
+
+// Write callStatus as an RFC 7807 problem document.
+func writeProblem(response http.ResponseWriter, request *http.Request, callStatus *status.Status) {
+	document, retryAfter := newProblem(callStatus, request.URL.Path)
+	if retryAfter > 0 {
+		response.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	}
+
+	body, _ := json.Marshal(document)
+
+	response.Header().Set("Content-Type", "application/problem+json")
+	response.WriteHeader(document.Status)
+	_, _ = response.Write(body)
+}
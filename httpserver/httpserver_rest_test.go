@@ -0,0 +1,222 @@
+package httpserver_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	"github.com/senzing-garage/sz-sdk-proto/go/szproduct"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type restTestSzEngine struct {
+	szengine.UnimplementedSzEngineServer
+}
+
+type restTestSzProduct struct {
+	szproduct.UnimplementedSzProductServer
+}
+
+// ----------------------------------------------------------------------------
+// Test interface methods
+// ----------------------------------------------------------------------------
+
+func TestBasicHTTPServer_RESTHandler(test *testing.T) {
+	ctx := test.Context()
+	handler := getRESTTestHandler(ctx, test)
+
+	// A Senzing result is the response body as is.
+
+	recorder := serveREST(ctx, handler, http.MethodGet, "/api/v1/product/version", "")
+	require.Equal(test, http.StatusOK, recorder.Code)
+	require.Equal(test, "application/json", recorder.Header().Get("Content-Type"))
+	require.JSONEq(test, `{"VERSION": "4.0.0"}`, recorder.Body.String())
+
+	// Path wildcards, query parameters and the default flags fill the request.
+
+	recorder = serveREST(ctx, handler, http.MethodGet, "/api/v1/entities/1", "")
+	require.Equal(test, http.StatusOK, recorder.Code)
+	require.JSONEq(test, `{"entityId": 1, "flags": `+jsonNumber(senzing.SzEntityDefaultFlags)+`}`, recorder.Body.String())
+
+	recorder = serveREST(ctx, handler, http.MethodGet, "/api/v1/entities/1?flags=0", "")
+	require.Equal(test, http.StatusOK, recorder.Code)
+	require.JSONEq(test, `{"entityId": 1, "flags": 0}`, recorder.Body.String())
+
+	// The request body fills the body field.
+
+	recorder = serveREST(ctx, handler, http.MethodPost, "/api/v1/search?search_profile=SEARCH", `{"NAME_FULL": "Bob"}`)
+	require.Equal(test, http.StatusOK, recorder.Code)
+	require.JSONEq(test, `{"attributes": {"NAME_FULL": "Bob"}, "searchProfile": "SEARCH"}`, recorder.Body.String())
+
+	// Results that are not JSON are encoded as JSON.
+
+	recorder = serveREST(ctx, handler, http.MethodGet, "/api/v1/config/active-id", "")
+	require.Equal(test, http.StatusOK, recorder.Code)
+	require.Equal(test, "1234", recorder.Body.String())
+
+	// Empty results have no body.
+
+	recorder = serveREST(ctx, handler, http.MethodDelete, "/api/v1/records/TEST/1", "")
+	require.Equal(test, http.StatusNoContent, recorder.Code)
+}
+
+func TestBasicHTTPServer_RESTHandlerHeaders(test *testing.T) {
+	ctx := test.Context()
+	handler := getRESTTestHandler(ctx, test)
+
+	request := httptest.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/product/license", nil)
+	request.Header.Set("X-Api-Key", "secret")
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	require.Equal(test, http.StatusOK, recorder.Code)
+	require.JSONEq(test, `{"apiKey": "secret"}`, recorder.Body.String())
+}
+
+func TestBasicHTTPServer_RESTHandlerProblems(test *testing.T) {
+	ctx := test.Context()
+	handler := getRESTTestHandler(ctx, test)
+
+	testCases := []struct {
+		code       string
+		httpStatus int
+		method     string
+		path       string
+	}{
+		{code: "NotFound", httpStatus: http.StatusNotFound, method: http.MethodGet, path: "/api/v1/entities/404"},
+		{code: "InvalidArgument", httpStatus: http.StatusBadRequest, method: http.MethodGet, path: "/api/v1/entities/bob"},
+		{code: "NotFound", httpStatus: http.StatusNotFound, method: http.MethodGet, path: "/api/v1/no-such-route"},
+		{code: "Unimplemented", httpStatus: http.StatusNotImplemented, method: http.MethodGet, path: "/api/v1/stats"},
+	}
+
+	for _, testCase := range testCases {
+		test.Run(testCase.path, func(test *testing.T) {
+			recorder := serveREST(ctx, handler, testCase.method, testCase.path, "")
+			require.Equal(test, testCase.httpStatus, recorder.Code)
+			require.Equal(test, "application/problem+json", recorder.Header().Get("Content-Type"))
+
+			document := map[string]any{}
+			err := json.Unmarshal(recorder.Body.Bytes(), &document)
+			require.NoError(test, err)
+			require.Equal(test, testCase.code, document["code"])
+			require.Equal(test, float64(testCase.httpStatus), document["status"])
+			require.Equal(test, testCase.path, document["instance"])
+			require.Equal(test, "about:blank", document["type"])
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods for the test gRPC services
+// ----------------------------------------------------------------------------
+
+func (server *restTestSzEngine) DeleteRecord(
+	ctx context.Context,
+	request *szengine.DeleteRecordRequest,
+) (*szengine.DeleteRecordResponse, error) {
+	_ = ctx
+	_ = request
+
+	return &szengine.DeleteRecordResponse{}, nil
+}
+
+func (server *restTestSzEngine) GetActiveConfigId(
+	ctx context.Context,
+	request *szengine.GetActiveConfigIdRequest,
+) (*szengine.GetActiveConfigIdResponse, error) {
+	_ = ctx
+	_ = request
+
+	return &szengine.GetActiveConfigIdResponse{Result: 1234}, nil
+}
+
+func (server *restTestSzEngine) GetEntityByEntityId(
+	ctx context.Context,
+	request *szengine.GetEntityByEntityIdRequest,
+) (*szengine.GetEntityByEntityIdResponse, error) {
+	_ = ctx
+
+	if request.GetEntityId() == http.StatusNotFound {
+		return nil, status.Error(codes.NotFound, "entity not found")
+	}
+
+	result, err := json.Marshal(map[string]int64{"entityId": request.GetEntityId(), "flags": request.GetFlags()})
+
+	return &szengine.GetEntityByEntityIdResponse{Result: string(result)}, err
+}
+
+func (server *restTestSzEngine) SearchByAttributes(
+	ctx context.Context,
+	request *szengine.SearchByAttributesRequest,
+) (*szengine.SearchByAttributesResponse, error) {
+	_ = ctx
+
+	result, err := json.Marshal(map[string]any{
+		"attributes":    json.RawMessage(request.GetAttributes()),
+		"searchProfile": request.GetSearchProfile(),
+	})
+
+	return &szengine.SearchByAttributesResponse{Result: string(result)}, err
+}
+
+func (server *restTestSzProduct) GetLicense(
+	ctx context.Context,
+	request *szproduct.GetLicenseRequest,
+) (*szproduct.GetLicenseResponse, error) {
+	_ = request
+
+	apiKey := metadata.ValueFromIncomingContext(ctx, "x-api-key")
+	result, err := json.Marshal(map[string]string{"apiKey": strings.Join(apiKey, ",")})
+
+	return &szproduct.GetLicenseResponse{Result: string(result)}, err
+}
+
+func (server *restTestSzProduct) GetVersion(
+	ctx context.Context,
+	request *szproduct.GetVersionRequest,
+) (*szproduct.GetVersionResponse, error) {
+	_ = ctx
+	_ = request
+
+	return &szproduct.GetVersionResponse{Result: `{"VERSION": "4.0.0"}`}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func getRESTTestHandler(ctx context.Context, test *testing.T) http.Handler {
+	test.Helper()
+
+	grpcServer := grpc.NewServer()
+	szengine.RegisterSzEngineServer(grpcServer, &restTestSzEngine{})
+	szproduct.RegisterSzProductServer(grpcServer, &restTestSzProduct{})
+
+	httpServer := getTestObject(ctx, test)
+	httpServer.GRPCServer = grpcServer
+
+	return httpServer.RESTHandler(ctx)
+}
+
+func jsonNumber(value int64) string {
+	result, _ := json.Marshal(value)
+
+	return string(result)
+}
+
+func serveREST(ctx context.Context, handler http.Handler, method string, path string, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequestWithContext(ctx, method, path, strings.NewReader(body))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	return recorder
+}
@@ -1,18 +1,66 @@
 package httpserver_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/senzing-garage/serve-grpc/httpserver"
+	"github.com/senzing-garage/serve-grpc/tlsreload"
+	"github.com/senzing-garage/serve-grpc/tracing"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // ----------------------------------------------------------------------------
 // Test interface methods
 // ----------------------------------------------------------------------------
 
+func TestBasicHTTPServer_Handler_grpcWebTraceContext(test *testing.T) {
+	ctx := test.Context()
+	spanRecorder := tracetest.NewSpanRecorder()
+	grpcServer := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler(
+		otelgrpc.WithPropagators(tracing.Propagator()),
+		otelgrpc.WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder))),
+	)))
+	healthpb.RegisterHealthServer(grpcServer, health.NewServer())
+
+	httpServer := getTestObject(ctx, test)
+	httpServer.GRPCServer = grpcServer
+
+	// An empty grpc.health.v1.HealthCheckRequest in a single uncompressed grpc-web frame.
+
+	body := bytes.NewReader([]byte{0, 0, 0, 0, 0})
+	request := httptest.NewRequestWithContext(ctx, http.MethodPost, "/grpc.health.v1.Health/Check", body)
+	request.Header.Set("Content-Type", "application/grpc-web+proto")
+	request.Header.Set("Traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	recorder := httptest.NewRecorder()
+	httpServer.Handler(ctx).ServeHTTP(recorder, request)
+	require.Equal(test, http.StatusOK, recorder.Code)
+
+	ended := spanRecorder.Ended()
+	require.Len(test, ended, 1)
+	require.Equal(test, "4bf92f3577b34da6a3ce929d0e0e4736", ended[0].SpanContext().TraceID().String())
+	require.Equal(test, "00f067aa0ba902b7", ended[0].Parent().SpanID().String())
+}
+
 func TestBasicHTTPServer_Serve(test *testing.T) {
 	ctx := test.Context()
 	httpServer := getTestObject(ctx, test)
@@ -20,10 +68,173 @@ func TestBasicHTTPServer_Serve(test *testing.T) {
 	require.NoError(test, err)
 }
 
+func TestBasicHTTPServer_Serve_singlePort(test *testing.T) {
+	ctx := test.Context()
+	grpcServer := grpc.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, health.NewServer())
+
+	httpServer := getTestObject(ctx, test)
+	httpServer.AvoidServing = false
+	httpServer.GRPCServer = grpcServer
+	httpServer.MetricsHandler = http.HandlerFunc(func(response http.ResponseWriter, _ *http.Request) {
+		_, _ = response.Write([]byte("metrics"))
+	})
+	httpServer.MetricsPath = "/metrics"
+	httpServer.ServerPort = getFreePort(test)
+	httpServer.SinglePort = true
+
+	served := make(chan error, 1)
+
+	go func() { served <- httpServer.Serve(ctx) }()
+
+	address := fmt.Sprintf("localhost:%d", httpServer.ServerPort)
+
+	// Native gRPC over HTTP/2 without TLS.
+
+	grpcClient, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(test, err)
+
+	defer grpcClient.Close()
+
+	var healthResponse *healthpb.HealthCheckResponse
+
+	require.Eventually(test, func() bool {
+		healthResponse, err = healthpb.NewHealthClient(grpcClient).Check(ctx, &healthpb.HealthCheckRequest{})
+
+		return err == nil
+	}, 5*time.Second, 20*time.Millisecond)
+	require.Equal(test, healthpb.HealthCheckResponse_SERVING, healthResponse.GetStatus())
+
+	// grpc-web and metrics over HTTP/1.1 on the same port.
+
+	request, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		"http://"+address+"/api/grpc.health.v1.Health/Check",
+		bytes.NewReader([]byte{0, 0, 0, 0, 0}),
+	)
+	require.NoError(test, err)
+	request.Header.Set("Content-Type", "application/grpc-web+proto")
+
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(test, err)
+	require.NoError(test, response.Body.Close())
+	require.Equal(test, http.StatusOK, response.StatusCode)
+	require.Equal(test, "application/grpc-web+proto", response.Header.Get("Content-Type"))
+
+	request, err = http.NewRequestWithContext(ctx, http.MethodGet, "http://"+address+"/metrics", nil)
+	require.NoError(test, err)
+
+	response, err = http.DefaultClient.Do(request)
+	require.NoError(test, err)
+
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	require.NoError(test, err)
+	require.Equal(test, "metrics", string(body))
+
+	require.NoError(test, httpServer.Shutdown(ctx))
+	require.NoError(test, <-served)
+}
+
+func TestBasicHTTPServer_Serve_tls(test *testing.T) {
+	ctx := test.Context()
+	reloader, err := tlsreload.NewReloader(
+		ctx,
+		"../testdata/certificates/server/certificate.pem",
+		"../testdata/certificates/server/private_key.pem",
+		"",
+		nil,
+	)
+	require.NoError(test, err)
+
+	httpServer := getTestObject(ctx, test)
+	httpServer.AvoidServing = false
+	httpServer.MetricsHandler = http.HandlerFunc(func(response http.ResponseWriter, _ *http.Request) {
+		_, _ = response.Write([]byte("metrics"))
+	})
+	httpServer.MetricsPath = "/metrics"
+	httpServer.ServerPort = getFreePort(test)
+	httpServer.TLSConfig = reloader.TLSConfig()
+
+	served := make(chan error, 1)
+
+	go func() { served <- httpServer.Serve(ctx) }()
+
+	// HTTPS clients that support HTTP/2 get it.
+
+	pemCertificateAuthority, err := os.ReadFile("../testdata/certificates/certificate-authority/certificate.pem")
+	require.NoError(test, err)
+
+	rootCAs := x509.NewCertPool()
+	require.True(test, rootCAs.AppendCertsFromPEM(pemCertificateAuthority))
+
+	client := &http.Client{Transport: &http.Transport{
+		ForceAttemptHTTP2: true,
+		TLSClientConfig:   &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: rootCAs},
+	}}
+	url := fmt.Sprintf("https://localhost:%d/metrics", httpServer.ServerPort)
+
+	var response *http.Response
+
+	require.Eventually(test, func() bool {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		require.NoError(test, err)
+
+		response, err = client.Do(request) //nolint:bodyclose // Closed below.
+
+		return err == nil
+	}, 5*time.Second, 20*time.Millisecond)
+
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	require.NoError(test, err)
+	require.Equal(test, http.StatusOK, response.StatusCode)
+	require.Equal(test, 2, response.ProtoMajor)
+	require.Equal(test, "metrics", string(body))
+
+	require.NoError(test, httpServer.Shutdown(ctx))
+	require.NoError(test, <-served)
+}
+
+func TestBasicHTTPServer_Shutdown(test *testing.T) {
+	ctx := test.Context()
+	httpServer := getTestObject(ctx, test)
+	err := httpServer.Serve(ctx)
+	require.NoError(test, err)
+	err = httpServer.Shutdown(ctx)
+	require.NoError(test, err)
+}
+
+func TestBasicHTTPServer_Shutdown_beforeServe(test *testing.T) {
+	ctx := test.Context()
+	httpServer := getTestObject(ctx, test)
+	err := httpServer.Shutdown(ctx)
+	require.NoError(test, err)
+	err = httpServer.Serve(ctx)
+	require.NoError(test, err)
+}
+
 // ----------------------------------------------------------------------------
 // Internal functions
 // ----------------------------------------------------------------------------
 
+func getFreePort(test *testing.T) int {
+	test.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(test, err)
+
+	defer listener.Close()
+
+	tcpAddr, isTCPAddr := listener.Addr().(*net.TCPAddr)
+	require.True(test, isTCPAddr)
+
+	return tcpAddr.Port
+}
+
 func getTestObject(ctx context.Context, t *testing.T) *httpserver.BasicHTTPServer {
 	t.Helper()
 
@@ -38,3 +249,13 @@ func getTestObject(ctx context.Context, t *testing.T) *httpserver.BasicHTTPServe
 
 	return result
 }
+
+// Write contents to a file named name in a temporary directory and return its path.
+func writeTestFile(test *testing.T, name string, contents string) string {
+	test.Helper()
+
+	filename := filepath.Join(test.TempDir(), name)
+	require.NoError(test, os.WriteFile(filename, []byte(contents), 0o600))
+
+	return filename
+}
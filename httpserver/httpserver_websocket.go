@@ -0,0 +1,535 @@
+package httpserver
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/senzing-garage/serve-grpc/proto/go/szadmin"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+A webSocketRoute maps a WebSocket path onto a server-streaming gRPC method.  The
+request message is bound from the path and query as for a restRoute, and each
+response message becomes one frame.
+*/
+type webSocketRoute struct {
+	restRoute
+	frame func(grpcResponse proto.Message, sequence int64) webSocketFrame
+}
+
+/*
+A webSocketFrame is a JSON text message sent to the client.  Type is "data" for a
+result, or "error" for the problem that ended the stream.
+*/
+type webSocketFrame struct {
+	Data       json.RawMessage `json:"data,omitempty"`
+	Dropped    int64           `json:"dropped,omitempty"` // Notifications missed since the previous frame.
+	Error      *problem        `json:"error,omitempty"`
+	RetryAfter int             `json:"retryAfter,omitempty"` // Seconds, as the Retry-After header of REST.
+	Sequence   int64           `json:"sequence,omitempty"`
+	Time       string          `json:"time,omitempty"`
+	Type       string          `json:"type"`
+}
+
+// A webSocketRequest is a JSON text message sent by the client.
+type webSocketRequest struct {
+	Credit int64 `json:"credit"` // Further data frames the client is ready to receive.
+}
+
+// webSocketResponseWriter passes the response of grpc.Server.ServeHTTP for one streaming call through a pipe.
+type webSocketResponseWriter struct {
+	restResponseWriter
+	pipe *io.PipeWriter
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// DefaultWebSocketRoutePrefix is where the WebSocket routes are served when WebSocketRoutePrefix is not set.
+const DefaultWebSocketRoutePrefix = "/ws"
+
+const (
+	webSocketCloseCodeBase  = 4000             // Close code of a gRPC error is 4000 plus its gRPC status code.
+	webSocketInitialCredit  = 64               // Data frames sent before the client grants credit, unless ?credit= is given.
+	webSocketMaxCredit      = 1 << 20          // Credit held at most, however much the client grants.
+	webSocketMaxReasonBytes = 123              // Bytes allowed in the reason of a close frame.
+	webSocketPingInterval   = 30 * time.Second // Keeps idle connections open through proxies.
+	webSocketReadLimit      = 1024             // Bytes allowed in a message from the client.
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// The WebSocket routes.
+var webSocketRoutes = []webSocketRoute{
+	{
+		restRoute: restRoute{
+			pattern:      "GET /exports/csv",
+			fullMethod:   szengine.SzEngine_StreamExportCsvEntityReport_FullMethodName,
+			newRequest:   func() proto.Message { return &szengine.StreamExportCsvEntityReportRequest{} },
+			newResponse:  func() proto.Message { return &szengine.StreamExportCsvEntityReportResponse{} },
+			defaultFlags: senzing.SzExportDefaultFlags,
+		},
+		frame: csvFrame,
+	},
+	{
+		restRoute: restRoute{
+			pattern:      "GET /exports/json",
+			fullMethod:   szengine.SzEngine_StreamExportJsonEntityReport_FullMethodName,
+			newRequest:   func() proto.Message { return &szengine.StreamExportJsonEntityReportRequest{} },
+			newResponse:  func() proto.Message { return &szengine.StreamExportJsonEntityReportResponse{} },
+			defaultFlags: senzing.SzExportDefaultFlags,
+		},
+		frame: jsonFrame,
+	},
+	{
+		restRoute: restRoute{
+			pattern:     "GET /notifications",
+			fullMethod:  szadmin.SzAdmin_WatchNotifications_FullMethodName,
+			newRequest:  func() proto.Message { return &szadmin.WatchNotificationsRequest{} },
+			newResponse: func() proto.Message { return &szadmin.Notification{} },
+		},
+		frame: notificationFrame,
+	},
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+The WebSocketHandler method gets the http.ServeMux for the WebSocket routes below
+WebSocketRoutePrefix.  Each connection streams one gRPC call as JSON text frames:
+
+  - GET {prefix}/exports/json: SzEngine.StreamExportJsonEntityReport, ?flags= optional.
+  - GET {prefix}/exports/csv: SzEngine.StreamExportCsvEntityReport, ?csv_column_list= and ?flags= optional.
+  - GET {prefix}/notifications: SzAdmin.WatchNotifications, the live observer notifications.
+
+The client controls the flow by granting credit: the server sends as many data
+frames as ?credit= (default 64) and then waits until the client sends
+{"credit": n} for n more.  An export then pauses, and the notification feed drops
+notifications and reports how many in the "dropped" field of the next frame.
+
+A stream that completes is closed with code 1000.  A stream that fails is sent an
+"error" frame holding an RFC 7807 problem document, and is closed with code 4000
+plus the gRPC status code, for example 4005 for NotFound or 4016 for
+Unauthenticated.  Each call goes through the gRPC server as REST calls do.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - httpServeMux - the Mux of the WebSocket routes.
+*/
+func (httpServer *BasicHTTPServer) WebSocketHandler(ctx context.Context) *http.ServeMux {
+	_ = ctx
+
+	prefix := httpServer.getWebSocketRoutePrefix()
+	webSocketMux := http.NewServeMux()
+
+	for _, route := range webSocketRoutes {
+		method, path, _ := strings.Cut(route.pattern, " ")
+		webSocketMux.HandleFunc(method+" "+prefix+path, httpServer.webSocketHandler(route))
+	}
+
+	webSocketMux.HandleFunc(prefix+"/", func(response http.ResponseWriter, request *http.Request) {
+		writeProblem(response, request, status.New(codes.NotFound, "no WebSocket route for "+request.URL.Path))
+	})
+
+	return webSocketMux
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods for http.ResponseWriter
+// ----------------------------------------------------------------------------
+
+func (writer *webSocketResponseWriter) Write(buffer []byte) (int, error) {
+	return writer.pipe.Write(buffer) //nolint:wrapcheck // Read back by invokeStream.
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (httpServer *BasicHTTPServer) registerWebSocket(ctx context.Context, rootMux *http.ServeMux) {
+	if httpServer.EnableAll || httpServer.EnableWebSocket {
+		prefix := httpServer.getWebSocketRoutePrefix()
+		rootMux.Handle(prefix+"/", httpServer.WebSocketHandler(ctx))
+		httpServer.log(2008, httpServer.webSocketScheme(), httpServer.ServerPort, prefix)
+	}
+}
+
+func (httpServer *BasicHTTPServer) getWebSocketRoutePrefix() string {
+	if len(httpServer.WebSocketRoutePrefix) > 0 {
+		return "/" + strings.Trim(httpServer.WebSocketRoutePrefix, "/")
+	}
+
+	return DefaultWebSocketRoutePrefix
+}
+
+// Stream route's gRPC method to a WebSocket connection.
+func (httpServer *BasicHTTPServer) webSocketHandler(route webSocketRoute) http.HandlerFunc {
+	return func(response http.ResponseWriter, request *http.Request) {
+		httpServer.log(1004, request.URL.Path)
+
+		grpcRequest, err := route.bind(request)
+		if err != nil {
+			writeProblem(response, request, status.New(codes.InvalidArgument, err.Error()))
+
+			return
+		}
+
+		credit, err := initialCredit(request)
+		if err != nil {
+			writeProblem(response, request, status.New(codes.InvalidArgument, err.Error()))
+
+			return
+		}
+
+		// CORSHandler has already refused origins outside CORSPolicy.
+
+		connection, err := websocket.Accept(response, request, &websocket.AcceptOptions{InsecureSkipVerify: true})
+		if err != nil {
+			return // Accept has written the HTTP error.
+		}
+
+		connection.SetReadLimit(webSocketReadLimit)
+		httpServer.streamWebSocket(request, connection, route, grpcRequest, credit)
+	}
+}
+
+/*
+Send the responses of route's gRPC method as frames while the client has credit,
+then close the connection with the call's status.  The call is canceled when the
+client goes away or breaks the protocol.
+
+The connection is closed by the library when a context given to it is done, so
+reads and writes use the context of request, which lasts until the handler returns,
+and only the call uses callCtx.
+*/
+func (httpServer *BasicHTTPServer) streamWebSocket(
+	request *http.Request,
+	connection *websocket.Conn,
+	route webSocketRoute,
+	grpcRequest proto.Message,
+	credit int64,
+) {
+	ctx := request.Context()
+	callCtx, cancelCall := context.WithCancel(ctx)
+
+	defer cancelCall()
+
+	credits := make(chan int64)
+	clientStatus := make(chan *status.Status, 1)
+
+	go func() {
+		clientStatus <- readCredits(ctx, callCtx, connection, credits)
+
+		cancelCall()
+	}()
+
+	go keepAlive(callCtx, connection)
+
+	var sequence int64
+
+	callStatus := httpServer.invokeStream(
+		request.WithContext(callCtx),
+		route.fullMethod,
+		grpcRequest,
+		route.newResponse,
+		func(grpcResponse proto.Message) error {
+			for credit == 0 {
+				select {
+				case <-callCtx.Done():
+					return callCtx.Err() //nolint:wrapcheck // Ends the call.
+				case granted := <-credits:
+					credit = min(credit+granted, webSocketMaxCredit)
+				}
+			}
+
+			sequence++
+			credit--
+
+			frame, err := json.Marshal(route.frame(grpcResponse, sequence))
+			if err != nil {
+				return err //nolint:wrapcheck // Ends the call.
+			}
+
+			return connection.Write(ctx, websocket.MessageText, frame) //nolint:wrapcheck // Ends the call.
+		},
+	)
+
+	// A client that broke the protocol is told so, rather than that its call was canceled.
+
+	select {
+	case readStatus := <-clientStatus:
+		if readStatus != nil {
+			callStatus = readStatus
+		}
+	default:
+	}
+
+	closeWebSocket(ctx, request, connection, callStatus)
+}
+
+/*
+Call the server-streaming fullMethod on the gRPC server in-process, as invoke does
+for unary methods, passing each response to receive as it arrives.  ServeHTTP
+writes into a pipe, so a receive that waits also holds the method's stream.Send,
+and a receive that fails cancels the call.
+*/
+func (httpServer *BasicHTTPServer) invokeStream(
+	request *http.Request,
+	fullMethod string,
+	grpcRequest proto.Message,
+	newResponse func() proto.Message,
+	receive func(grpcResponse proto.Message) error,
+) *status.Status {
+	ctx, cancel := context.WithCancel(request.Context())
+	defer cancel()
+
+	grpcHTTPRequest, err := newGRPCHTTPRequest(request.WithContext(ctx), fullMethod, grpcRequest)
+	if err != nil {
+		return status.New(codes.Internal, err.Error())
+	}
+
+	pipeReader, pipeWriter := io.Pipe()
+	writer := &webSocketResponseWriter{
+		restResponseWriter: restResponseWriter{header: http.Header{}},
+		pipe:               pipeWriter,
+	}
+	served := make(chan struct{})
+
+	go func() {
+		defer close(served)
+
+		httpServer.GRPCServer.ServeHTTP(writer, grpcHTTPRequest)
+		_ = pipeWriter.Close()
+	}()
+
+	err = readGRPCMessages(pipeReader, newResponse, receive)
+
+	// Stop a call that is still sending, then read the status it ended with.
+
+	if err != nil {
+		cancel()
+	}
+
+	_ = pipeReader.CloseWithError(io.ErrClosedPipe)
+
+	<-served
+
+	callStatus := writer.status()
+	if callStatus.Code() == codes.OK && err != nil {
+		return status.New(codes.Internal, err.Error())
+	}
+
+	return callStatus
+}
+
+// The WebSocket URL scheme of the served endpoints, for log messages.
+func (httpServer *BasicHTTPServer) webSocketScheme() string {
+	if httpServer.TLSConfig != nil {
+		return "wss"
+	}
+
+	return "ws"
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+/*
+Close connection with callStatus: code 1000 if it is OK, else an "error" frame
+and code 4000 plus the gRPC status code.
+*/
+func closeWebSocket(ctx context.Context, request *http.Request, connection *websocket.Conn, callStatus *status.Status) {
+	if callStatus.Code() == codes.OK {
+		_ = connection.Close(websocket.StatusNormalClosure, "")
+
+		return
+	}
+
+	document, retryAfter := newProblem(callStatus, request.URL.Path)
+	frame, _ := json.Marshal(webSocketFrame{Error: &document, RetryAfter: retryAfter, Type: "error"})
+	_ = connection.Write(ctx, websocket.MessageText, frame)
+
+	closeCode := websocket.StatusCode(webSocketCloseCodeBase + int(callStatus.Code())) //nolint:gosec // gRPC codes are small.
+	_ = connection.Close(closeCode, truncateReason(callStatus.Message()))
+}
+
+// A frame of a CSV export: the line as a JSON string.
+func csvFrame(grpcResponse proto.Message, sequence int64) webSocketFrame {
+	line := strings.TrimRight(grpcResponse.(*szengine.StreamExportCsvEntityReportResponse).GetResult(), "\r\n") //nolint:forcetypeassert
+	data, _ := json.Marshal(line)
+
+	return webSocketFrame{Data: data, Sequence: sequence, Type: "data"}
+}
+
+// The credit granted by ?credit=, else webSocketInitialCredit.
+func initialCredit(request *http.Request) (int64, error) {
+	query := request.URL.Query()
+	if !query.Has("credit") {
+		return webSocketInitialCredit, nil
+	}
+
+	credit, err := strconv.ParseInt(query.Get("credit"), 10, 64)
+	if err != nil || credit < 1 {
+		return 0, errors.New("credit must be a positive integer: " + query.Get("credit")) //nolint:err113
+	}
+
+	return min(credit, webSocketMaxCredit), nil
+}
+
+// The message itself if it is JSON, such as a Senzing result, else the message as a JSON string.
+func jsonData(message string) json.RawMessage {
+	if json.Valid([]byte(message)) {
+		return json.RawMessage(message)
+	}
+
+	result, _ := json.Marshal(message)
+
+	return result
+}
+
+// A frame of a JSON export: the entity.
+func jsonFrame(grpcResponse proto.Message, sequence int64) webSocketFrame {
+	entity := strings.TrimSpace(grpcResponse.(*szengine.StreamExportJsonEntityReportResponse).GetResult()) //nolint:forcetypeassert
+
+	return webSocketFrame{Data: jsonData(entity), Sequence: sequence, Type: "data"}
+}
+
+// Ping the client until ctx is done, closing connections that no longer answer.  Only an unanswered ping closes.
+func keepAlive(ctx context.Context, connection *websocket.Conn) {
+	ticker := time.NewTicker(webSocketPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			pingCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), webSocketPingInterval)
+			err := connection.Ping(pingCtx)
+
+			cancel()
+
+			if err != nil {
+				_ = connection.CloseNow()
+
+				return
+			}
+		}
+	}
+}
+
+// A frame of the notification feed.
+func notificationFrame(grpcResponse proto.Message, sequence int64) webSocketFrame {
+	_ = sequence
+
+	notification := grpcResponse.(*szadmin.Notification) //nolint:forcetypeassert
+
+	return webSocketFrame{
+		Data:     jsonData(notification.GetMessage()),
+		Dropped:  notification.GetDropped(),
+		Sequence: notification.GetSequence(),
+		Time:     notification.GetTime().AsTime().Format(time.RFC3339Nano),
+		Type:     "data",
+	}
+}
+
+/*
+Read the client's credit grants into credits until the connection or the call ends.
+The status is nil when the client closed or went away, and InvalidArgument when it
+sent something other than a credit grant.
+*/
+func readCredits(ctx context.Context, callCtx context.Context, connection *websocket.Conn, credits chan<- int64) *status.Status {
+	for {
+		messageType, message, err := connection.Read(ctx)
+		if err != nil {
+			return nil
+		}
+
+		var clientRequest webSocketRequest
+
+		err = json.Unmarshal(message, &clientRequest)
+		if messageType != websocket.MessageText || err != nil || clientRequest.Credit < 1 {
+			return status.New(codes.InvalidArgument, `messages must be {"credit": n} with n a positive integer`)
+		}
+
+		select {
+		case <-callCtx.Done():
+			return nil
+		case credits <- clientRequest.Credit:
+		}
+	}
+}
+
+// Pass each length-prefixed gRPC message read from reader to receive, until reader ends or receive fails.
+func readGRPCMessages(reader io.Reader, newResponse func() proto.Message, receive func(proto.Message) error) error {
+	header := make([]byte, grpcFrameHeaderSize)
+
+	for {
+		_, err := io.ReadFull(reader, header)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err //nolint:wrapcheck // Reported to the client as is.
+		}
+
+		length := binary.BigEndian.Uint32(header[1:])
+		if length > restMaxBodyBytes {
+			return errors.New("the gRPC response is too large") //nolint:err113
+		}
+
+		message := make([]byte, length)
+
+		_, err = io.ReadFull(reader, message)
+		if err != nil {
+			return err //nolint:wrapcheck // Reported to the client as is.
+		}
+
+		grpcResponse := newResponse()
+
+		err = proto.Unmarshal(message, grpcResponse)
+		if err != nil {
+			return err //nolint:wrapcheck // Reported to the client as is.
+		}
+
+		err = receive(grpcResponse)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Truncate reason to what fits in a close frame, without splitting a UTF-8 character.
+func truncateReason(reason string) string {
+	if len(reason) <= webSocketMaxReasonBytes {
+		return reason
+	}
+
+	return strings.ToValidUTF8(reason[:webSocketMaxReasonBytes], "")
+}
@@ -0,0 +1,252 @@
+package httpserver_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	szadminpb "github.com/senzing-garage/serve-grpc/proto/go/szadmin"
+	"github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type webSocketTestSzAdmin struct {
+	szadminpb.UnimplementedSzAdminServer
+}
+
+type webSocketTestSzEngine struct {
+	szengine.UnimplementedSzEngineServer
+}
+
+type webSocketTestFrame struct {
+	Data     json.RawMessage `json:"data"`
+	Dropped  int64           `json:"dropped"`
+	Error    map[string]any  `json:"error"`
+	Sequence int64           `json:"sequence"`
+	Time     string          `json:"time"`
+	Type     string          `json:"type"`
+}
+
+const webSocketTestRows = 3
+
+// ----------------------------------------------------------------------------
+// Test interface methods
+// ----------------------------------------------------------------------------
+
+func TestBasicHTTPServer_WebSocketHandler(test *testing.T) {
+	ctx := test.Context()
+	serverURL := getWebSocketTestServer(ctx, test)
+
+	// One frame is sent per credit.
+
+	connection := dialWebSocket(ctx, test, serverURL+"/ws/exports/json?credit=1&flags=7")
+	frame := readWebSocketFrame(ctx, test, connection)
+	require.Equal(test, "data", frame.Type)
+	require.Equal(test, int64(1), frame.Sequence)
+	require.JSONEq(test, `{"RESOLVED_ENTITY": {"ENTITY_ID": 1}, "FLAGS": 7}`, string(frame.Data))
+
+	err := connection.Write(ctx, websocket.MessageText, []byte(`{"credit": 2}`))
+	require.NoError(test, err)
+
+	for sequence := int64(2); sequence <= webSocketTestRows; sequence++ {
+		frame = readWebSocketFrame(ctx, test, connection)
+		require.Equal(test, sequence, frame.Sequence)
+	}
+
+	// A completed export is closed normally.
+
+	_, _, err = connection.Read(ctx)
+	require.Equal(test, websocket.StatusNormalClosure, websocket.CloseStatus(err))
+
+	// CSV lines are JSON strings.
+
+	connection = dialWebSocket(ctx, test, serverURL+"/ws/exports/csv?csv_column_list=RESOLVED_ENTITY_ID")
+	frame = readWebSocketFrame(ctx, test, connection)
+	require.JSONEq(test, `"RESOLVED_ENTITY_ID"`, string(frame.Data))
+
+	frame = readWebSocketFrame(ctx, test, connection)
+	require.JSONEq(test, `"1"`, string(frame.Data))
+}
+
+func TestBasicHTTPServer_WebSocketHandlerErrors(test *testing.T) {
+	ctx := test.Context()
+	serverURL := getWebSocketTestServer(ctx, test)
+
+	// A gRPC error is an error frame and the close code 4000 plus its code.
+
+	connection := dialWebSocket(ctx, test, serverURL+"/ws/exports/csv?csv_column_list=MISSING")
+	frame := readWebSocketFrame(ctx, test, connection)
+	require.Equal(test, "error", frame.Type)
+	require.Equal(test, "NotFound", frame.Error["code"])
+	require.Equal(test, "/ws/exports/csv", frame.Error["instance"])
+
+	_, _, err := connection.Read(ctx)
+	require.Equal(test, websocket.StatusCode(4000+codes.NotFound), websocket.CloseStatus(err))
+
+	// Anything but a credit grant from the client is InvalidArgument.
+
+	connection = dialWebSocket(ctx, test, serverURL+"/ws/exports/json?credit=1")
+	_ = readWebSocketFrame(ctx, test, connection)
+
+	err = connection.Write(ctx, websocket.MessageText, []byte(`{"credit": "more"}`))
+	require.NoError(test, err)
+
+	frame = readWebSocketFrame(ctx, test, connection)
+	require.Equal(test, "InvalidArgument", frame.Error["code"])
+
+	_, _, err = connection.Read(ctx)
+	require.Equal(test, websocket.StatusCode(4000+codes.InvalidArgument), websocket.CloseStatus(err))
+
+	// Requests that cannot be bound are refused before the upgrade.
+
+	response, err := http.Get(strings.Replace(serverURL, "ws:", "http:", 1) + "/ws/exports/json?credit=none") //nolint:noctx
+	require.NoError(test, err)
+
+	defer response.Body.Close()
+
+	require.Equal(test, http.StatusBadRequest, response.StatusCode)
+	require.Equal(test, "application/problem+json", response.Header.Get("Content-Type"))
+}
+
+func TestBasicHTTPServer_WebSocketHandlerNotifications(test *testing.T) {
+	ctx := test.Context()
+	serverURL := getWebSocketTestServer(ctx, test)
+
+	connection := dialWebSocket(ctx, test, serverURL+"/ws/notifications")
+	frame := readWebSocketFrame(ctx, test, connection)
+	require.Equal(test, "data", frame.Type)
+	require.Equal(test, int64(10), frame.Sequence)
+	require.Equal(test, int64(2), frame.Dropped)
+	require.Equal(test, "2026-01-02T03:04:05Z", frame.Time)
+	require.JSONEq(test, `{"subjectId": "1", "messageId": "8001"}`, string(frame.Data))
+}
+
+// ----------------------------------------------------------------------------
+// Interface methods for the test gRPC services
+// ----------------------------------------------------------------------------
+
+func (server *webSocketTestSzAdmin) WatchNotifications(
+	request *szadminpb.WatchNotificationsRequest,
+	stream szadminpb.SzAdmin_WatchNotificationsServer,
+) error {
+	_ = request
+
+	err := stream.Send(&szadminpb.Notification{
+		Sequence: 10,
+		Message:  `{"subjectId": "1", "messageId": "8001"}`,
+		Time:     timestamppb.New(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)),
+		Dropped:  2,
+	})
+	if err != nil {
+		return err
+	}
+
+	<-stream.Context().Done()
+
+	return nil
+}
+
+func (server *webSocketTestSzEngine) StreamExportCsvEntityReport(
+	request *szengine.StreamExportCsvEntityReportRequest,
+	stream szengine.SzEngine_StreamExportCsvEntityReportServer,
+) error {
+	if request.GetCsvColumnList() == "MISSING" {
+		return status.Error(codes.NotFound, "no such column")
+	}
+
+	err := stream.Send(&szengine.StreamExportCsvEntityReportResponse{Result: request.GetCsvColumnList() + "\n"})
+	if err != nil {
+		return err
+	}
+
+	for entityID := 1; entityID <= webSocketTestRows; entityID++ {
+		err = stream.Send(&szengine.StreamExportCsvEntityReportResponse{Result: strconv.Itoa(entityID) + "\n"})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (server *webSocketTestSzEngine) StreamExportJsonEntityReport(
+	request *szengine.StreamExportJsonEntityReportRequest,
+	stream szengine.SzEngine_StreamExportJsonEntityReportServer,
+) error {
+	for entityID := 1; entityID <= webSocketTestRows; entityID++ {
+		result, err := json.Marshal(map[string]any{
+			"RESOLVED_ENTITY": map[string]int{"ENTITY_ID": entityID},
+			"FLAGS":           request.GetFlags(),
+		})
+		if err != nil {
+			return err
+		}
+
+		err = stream.Send(&szengine.StreamExportJsonEntityReportResponse{Result: string(result) + "\n"})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+func dialWebSocket(ctx context.Context, test *testing.T, url string) *websocket.Conn {
+	test.Helper()
+
+	connection, response, err := websocket.Dial(ctx, url, nil)
+	require.NoError(test, err)
+
+	if response.Body != nil {
+		_ = response.Body.Close()
+	}
+
+	test.Cleanup(func() { _ = connection.CloseNow() })
+
+	return connection
+}
+
+// Serve the WebSocket routes over test gRPC services, returning the ws:// URL of the server.
+func getWebSocketTestServer(ctx context.Context, test *testing.T) string {
+	test.Helper()
+
+	grpcServer := grpc.NewServer()
+	szadminpb.RegisterSzAdminServer(grpcServer, &webSocketTestSzAdmin{})
+	szengine.RegisterSzEngineServer(grpcServer, &webSocketTestSzEngine{})
+
+	httpServer := getTestObject(ctx, test)
+	httpServer.GRPCServer = grpcServer
+
+	server := httptest.NewServer(httpServer.WebSocketHandler(ctx))
+	test.Cleanup(server.Close)
+
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func readWebSocketFrame(ctx context.Context, test *testing.T, connection *websocket.Conn) webSocketTestFrame {
+	test.Helper()
+
+	messageType, message, err := connection.Read(ctx)
+	require.NoError(test, err)
+	require.Equal(test, websocket.MessageText, messageType)
+
+	result := webSocketTestFrame{}
+	err = json.Unmarshal(message, &result)
+	require.NoError(test, err)
+
+	return result
+}
@@ -2,6 +2,7 @@ package httpserver
 
 import (
 	"context"
+	"errors"
 	"net/http"
 )
 
@@ -13,6 +14,7 @@ import (
 type HTTPServer interface {
 	Handler(ctx context.Context) *http.ServeMux
 	Serve(ctx context.Context) error
+	Shutdown(ctx context.Context) error
 }
 
 // ----------------------------------------------------------------------------
@@ -34,9 +36,21 @@ var IDMessages = map[int]string{
 	1000: "HTTP Web request: %+v",
 	1001: "gRPC Cors request: %+v",
 	1002: "gRPC Web request: %+v",
+	1003: "REST request: %s %s",
+	1004: "WebSocket request: %s",
 	2001: "Starting HTTP server on interface:port '%s'",
-	2002: "Serving GRPC over HTTP at http://localhost:%d/%s",
+	2002: "Serving GRPC over HTTP at %s://localhost:%d/%s",
+	2003: "Shutting down HTTP server.",
+	2004: "Serving Prometheus metrics at %s://localhost:%d%s",
+	2005: "Serving REST at %s://localhost:%d%s",
+	2006: "Serving OpenAPI at %s://localhost:%d%s and the API explorer at %s://localhost:%d%s",
+	2007: "Serving native gRPC at %s://localhost:%d",
+	2008: "Serving WebSocket streams at %s://localhost:%d%s",
+	3001: "Graceful shutdown of HTTP server did not complete (%v). Closing connections.",
+	3002: "Refused cross-origin request from %s to %s %s: %s",
 }
 
 // Status strings for specific messages.
 var IDStatuses = map[int]string{}
+
+var errForPackage = errors.New("httpserver")
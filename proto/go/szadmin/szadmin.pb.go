@@ -0,0 +1,1180 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: szadmin.proto
+
+package szadmin
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RedoState int32
+
+const (
+	RedoState_REDO_STATE_UNSPECIFIED RedoState = 0
+	RedoState_REDO_STATE_DISABLED    RedoState = 1 // The server was started without redo processing.
+	RedoState_REDO_STATE_RUNNING     RedoState = 2
+	RedoState_REDO_STATE_PAUSED      RedoState = 3
+)
+
+// Enum value maps for RedoState.
+var (
+	RedoState_name = map[int32]string{
+		0: "REDO_STATE_UNSPECIFIED",
+		1: "REDO_STATE_DISABLED",
+		2: "REDO_STATE_RUNNING",
+		3: "REDO_STATE_PAUSED",
+	}
+	RedoState_value = map[string]int32{
+		"REDO_STATE_UNSPECIFIED": 0,
+		"REDO_STATE_DISABLED":    1,
+		"REDO_STATE_RUNNING":     2,
+		"REDO_STATE_PAUSED":      3,
+	}
+)
+
+func (x RedoState) Enum() *RedoState {
+	p := new(RedoState)
+	*p = x
+	return p
+}
+
+func (x RedoState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RedoState) Descriptor() protoreflect.EnumDescriptor {
+	return file_szadmin_proto_enumTypes[0].Descriptor()
+}
+
+func (RedoState) Type() protoreflect.EnumType {
+	return &file_szadmin_proto_enumTypes[0]
+}
+
+func (x RedoState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RedoState.Descriptor instead.
+func (RedoState) EnumDescriptor() ([]byte, []int) {
+	return file_szadmin_proto_rawDescGZIP(), []int{0}
+}
+
+type FileExportFormat int32
+
+const (
+	FileExportFormat_FILE_EXPORT_FORMAT_UNSPECIFIED FileExportFormat = 0
+	FileExportFormat_FILE_EXPORT_FORMAT_NDJSON      FileExportFormat = 1 // ExportJsonEntityReport, one entity per line.
+	FileExportFormat_FILE_EXPORT_FORMAT_CSV         FileExportFormat = 2 // ExportCsvEntityReport, with the header at the top of every file.
+	FileExportFormat_FILE_EXPORT_FORMAT_PARQUET     FileExportFormat = 3 // ExportCsvEntityReport, one string column per CSV column.
+)
+
+// Enum value maps for FileExportFormat.
+var (
+	FileExportFormat_name = map[int32]string{
+		0: "FILE_EXPORT_FORMAT_UNSPECIFIED",
+		1: "FILE_EXPORT_FORMAT_NDJSON",
+		2: "FILE_EXPORT_FORMAT_CSV",
+		3: "FILE_EXPORT_FORMAT_PARQUET",
+	}
+	FileExportFormat_value = map[string]int32{
+		"FILE_EXPORT_FORMAT_UNSPECIFIED": 0,
+		"FILE_EXPORT_FORMAT_NDJSON":      1,
+		"FILE_EXPORT_FORMAT_CSV":         2,
+		"FILE_EXPORT_FORMAT_PARQUET":     3,
+	}
+)
+
+func (x FileExportFormat) Enum() *FileExportFormat {
+	p := new(FileExportFormat)
+	*p = x
+	return p
+}
+
+func (x FileExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FileExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_szadmin_proto_enumTypes[1].Descriptor()
+}
+
+func (FileExportFormat) Type() protoreflect.EnumType {
+	return &file_szadmin_proto_enumTypes[1]
+}
+
+func (x FileExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FileExportFormat.Descriptor instead.
+func (FileExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_szadmin_proto_rawDescGZIP(), []int{1}
+}
+
+type FileExportCompression int32
+
+const (
+	FileExportCompression_FILE_EXPORT_COMPRESSION_NONE FileExportCompression = 0
+	FileExportCompression_FILE_EXPORT_COMPRESSION_GZIP FileExportCompression = 1
+	FileExportCompression_FILE_EXPORT_COMPRESSION_ZSTD FileExportCompression = 2 // Parquet files compress their pages instead of the whole file.
+)
+
+// Enum value maps for FileExportCompression.
+var (
+	FileExportCompression_name = map[int32]string{
+		0: "FILE_EXPORT_COMPRESSION_NONE",
+		1: "FILE_EXPORT_COMPRESSION_GZIP",
+		2: "FILE_EXPORT_COMPRESSION_ZSTD",
+	}
+	FileExportCompression_value = map[string]int32{
+		"FILE_EXPORT_COMPRESSION_NONE": 0,
+		"FILE_EXPORT_COMPRESSION_GZIP": 1,
+		"FILE_EXPORT_COMPRESSION_ZSTD": 2,
+	}
+)
+
+func (x FileExportCompression) Enum() *FileExportCompression {
+	p := new(FileExportCompression)
+	*p = x
+	return p
+}
+
+func (x FileExportCompression) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FileExportCompression) Descriptor() protoreflect.EnumDescriptor {
+	return file_szadmin_proto_enumTypes[2].Descriptor()
+}
+
+func (FileExportCompression) Type() protoreflect.EnumType {
+	return &file_szadmin_proto_enumTypes[2]
+}
+
+func (x FileExportCompression) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FileExportCompression.Descriptor instead.
+func (FileExportCompression) EnumDescriptor() ([]byte, []int) {
+	return file_szadmin_proto_rawDescGZIP(), []int{2}
+}
+
+type FileExportState int32
+
+const (
+	FileExportState_FILE_EXPORT_STATE_UNSPECIFIED FileExportState = 0
+	FileExportState_FILE_EXPORT_STATE_RUNNING     FileExportState = 1
+	FileExportState_FILE_EXPORT_STATE_SUCCEEDED   FileExportState = 2
+	FileExportState_FILE_EXPORT_STATE_FAILED      FileExportState = 3
+	FileExportState_FILE_EXPORT_STATE_CANCELED    FileExportState = 4 // The server shut down before the export finished.
+)
+
+// Enum value maps for FileExportState.
+var (
+	FileExportState_name = map[int32]string{
+		0: "FILE_EXPORT_STATE_UNSPECIFIED",
+		1: "FILE_EXPORT_STATE_RUNNING",
+		2: "FILE_EXPORT_STATE_SUCCEEDED",
+		3: "FILE_EXPORT_STATE_FAILED",
+		4: "FILE_EXPORT_STATE_CANCELED",
+	}
+	FileExportState_value = map[string]int32{
+		"FILE_EXPORT_STATE_UNSPECIFIED": 0,
+		"FILE_EXPORT_STATE_RUNNING":     1,
+		"FILE_EXPORT_STATE_SUCCEEDED":   2,
+		"FILE_EXPORT_STATE_FAILED":      3,
+		"FILE_EXPORT_STATE_CANCELED":    4,
+	}
+)
+
+func (x FileExportState) Enum() *FileExportState {
+	p := new(FileExportState)
+	*p = x
+	return p
+}
+
+func (x FileExportState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FileExportState) Descriptor() protoreflect.EnumDescriptor {
+	return file_szadmin_proto_enumTypes[3].Descriptor()
+}
+
+func (FileExportState) Type() protoreflect.EnumType {
+	return &file_szadmin_proto_enumTypes[3]
+}
+
+func (x FileExportState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FileExportState.Descriptor instead.
+func (FileExportState) EnumDescriptor() ([]byte, []int) {
+	return file_szadmin_proto_rawDescGZIP(), []int{3}
+}
+
+type GetRedoStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRedoStatusRequest) Reset() {
+	*x = GetRedoStatusRequest{}
+	mi := &file_szadmin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRedoStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRedoStatusRequest) ProtoMessage() {}
+
+func (x *GetRedoStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szadmin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRedoStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetRedoStatusRequest) Descriptor() ([]byte, []int) {
+	return file_szadmin_proto_rawDescGZIP(), []int{0}
+}
+
+type PauseRedoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseRedoRequest) Reset() {
+	*x = PauseRedoRequest{}
+	mi := &file_szadmin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseRedoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseRedoRequest) ProtoMessage() {}
+
+func (x *PauseRedoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szadmin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseRedoRequest.ProtoReflect.Descriptor instead.
+func (*PauseRedoRequest) Descriptor() ([]byte, []int) {
+	return file_szadmin_proto_rawDescGZIP(), []int{1}
+}
+
+type ResumeRedoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeRedoRequest) Reset() {
+	*x = ResumeRedoRequest{}
+	mi := &file_szadmin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeRedoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeRedoRequest) ProtoMessage() {}
+
+func (x *ResumeRedoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szadmin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeRedoRequest.ProtoReflect.Descriptor instead.
+func (*ResumeRedoRequest) Descriptor() ([]byte, []int) {
+	return file_szadmin_proto_rawDescGZIP(), []int{2}
+}
+
+type RedoStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         RedoState              `protobuf:"varint,1,opt,name=state,proto3,enum=szadmin.RedoState" json:"state,omitempty"`
+	Workers       int32                  `protobuf:"varint,2,opt,name=workers,proto3" json:"workers,omitempty"`
+	BusyWorkers   int32                  `protobuf:"varint,3,opt,name=busy_workers,json=busyWorkers,proto3" json:"busy_workers,omitempty"` // Workers processing a redo record right now.
+	Processed     int64                  `protobuf:"varint,4,opt,name=processed,proto3" json:"processed,omitempty"`                        // Redo records processed successfully since the server started.
+	Failed        int64                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`                              // Redo records whose processing failed since the server started.
+	LastError     string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Queued        int64                  `protobuf:"varint,7,opt,name=queued,proto3" json:"queued,omitempty"` // CountRedoRecords at the time of the call; -1 if it failed.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedoStatusResponse) Reset() {
+	*x = RedoStatusResponse{}
+	mi := &file_szadmin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedoStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedoStatusResponse) ProtoMessage() {}
+
+func (x *RedoStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szadmin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedoStatusResponse.ProtoReflect.Descriptor instead.
+func (*RedoStatusResponse) Descriptor() ([]byte, []int) {
+	return file_szadmin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RedoStatusResponse) GetState() RedoState {
+	if x != nil {
+		return x.State
+	}
+	return RedoState_REDO_STATE_UNSPECIFIED
+}
+
+func (x *RedoStatusResponse) GetWorkers() int32 {
+	if x != nil {
+		return x.Workers
+	}
+	return 0
+}
+
+func (x *RedoStatusResponse) GetBusyWorkers() int32 {
+	if x != nil {
+		return x.BusyWorkers
+	}
+	return 0
+}
+
+func (x *RedoStatusResponse) GetProcessed() int64 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *RedoStatusResponse) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *RedoStatusResponse) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *RedoStatusResponse) GetQueued() int64 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+type ListExportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExportsRequest) Reset() {
+	*x = ListExportsRequest{}
+	mi := &file_szadmin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExportsRequest) ProtoMessage() {}
+
+func (x *ListExportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szadmin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExportsRequest.ProtoReflect.Descriptor instead.
+func (*ListExportsRequest) Descriptor() ([]byte, []int) {
+	return file_szadmin_proto_rawDescGZIP(), []int{4}
+}
+
+type ListExportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exports       []*ExportInfo          `protobuf:"bytes,1,rep,name=exports,proto3" json:"exports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExportsResponse) Reset() {
+	*x = ListExportsResponse{}
+	mi := &file_szadmin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExportsResponse) ProtoMessage() {}
+
+func (x *ListExportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szadmin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExportsResponse.ProtoReflect.Descriptor instead.
+func (*ListExportsResponse) Descriptor() ([]byte, []int) {
+	return file_szadmin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListExportsResponse) GetExports() []*ExportInfo {
+	if x != nil {
+		return x.Exports
+	}
+	return nil
+}
+
+type GetExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExportId      int64                  `protobuf:"varint,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExportRequest) Reset() {
+	*x = GetExportRequest{}
+	mi := &file_szadmin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExportRequest) ProtoMessage() {}
+
+func (x *GetExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szadmin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExportRequest.ProtoReflect.Descriptor instead.
+func (*GetExportRequest) Descriptor() ([]byte, []int) {
+	return file_szadmin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetExportRequest) GetExportId() int64 {
+	if x != nil {
+		return x.ExportId
+	}
+	return 0
+}
+
+type ExportInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExportId      int64                  `protobuf:"varint,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	Owner         string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`   // Principal name, or empty without authentication.
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"` // "csv" or "json".
+	Flags         int64                  `protobuf:"varint,4,opt,name=flags,proto3" json:"flags,omitempty"`
+	CsvColumnList string                 `protobuf:"bytes,5,opt,name=csv_column_list,json=csvColumnList,proto3" json:"csv_column_list,omitempty"`
+	Created       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+	LastAccess    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_access,json=lastAccess,proto3" json:"last_access,omitempty"`
+	RowsFetched   int64                  `protobuf:"varint,8,opt,name=rows_fetched,json=rowsFetched,proto3" json:"rows_fetched,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportInfo) Reset() {
+	*x = ExportInfo{}
+	mi := &file_szadmin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportInfo) ProtoMessage() {}
+
+func (x *ExportInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_szadmin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportInfo.ProtoReflect.Descriptor instead.
+func (*ExportInfo) Descriptor() ([]byte, []int) {
+	return file_szadmin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExportInfo) GetExportId() int64 {
+	if x != nil {
+		return x.ExportId
+	}
+	return 0
+}
+
+func (x *ExportInfo) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ExportInfo) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportInfo) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *ExportInfo) GetCsvColumnList() string {
+	if x != nil {
+		return x.CsvColumnList
+	}
+	return ""
+}
+
+func (x *ExportInfo) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *ExportInfo) GetLastAccess() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccess
+	}
+	return nil
+}
+
+func (x *ExportInfo) GetRowsFetched() int64 {
+	if x != nil {
+		return x.RowsFetched
+	}
+	return 0
+}
+
+type StartFileExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        FileExportFormat       `protobuf:"varint,1,opt,name=format,proto3,enum=szadmin.FileExportFormat" json:"format,omitempty"`
+	Compression   FileExportCompression  `protobuf:"varint,2,opt,name=compression,proto3,enum=szadmin.FileExportCompression" json:"compression,omitempty"`
+	Flags         int64                  `protobuf:"varint,3,opt,name=flags,proto3" json:"flags,omitempty"`
+	CsvColumnList string                 `protobuf:"bytes,4,opt,name=csv_column_list,json=csvColumnList,proto3" json:"csv_column_list,omitempty"` // CSV and Parquet only.
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`                                          // Files are named <name>-00001.<extension> and so on; empty uses "export-<job_id>".
+	MaxFileBytes  int64                  `protobuf:"varint,6,opt,name=max_file_bytes,json=maxFileBytes,proto3" json:"max_file_bytes,omitempty"`   // Start a new file after this many bytes; 0 writes one file.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartFileExportRequest) Reset() {
+	*x = StartFileExportRequest{}
+	mi := &file_szadmin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartFileExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartFileExportRequest) ProtoMessage() {}
+
+func (x *StartFileExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szadmin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartFileExportRequest.ProtoReflect.Descriptor instead.
+func (*StartFileExportRequest) Descriptor() ([]byte, []int) {
+	return file_szadmin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StartFileExportRequest) GetFormat() FileExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return FileExportFormat_FILE_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *StartFileExportRequest) GetCompression() FileExportCompression {
+	if x != nil {
+		return x.Compression
+	}
+	return FileExportCompression_FILE_EXPORT_COMPRESSION_NONE
+}
+
+func (x *StartFileExportRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *StartFileExportRequest) GetCsvColumnList() string {
+	if x != nil {
+		return x.CsvColumnList
+	}
+	return ""
+}
+
+func (x *StartFileExportRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartFileExportRequest) GetMaxFileBytes() int64 {
+	if x != nil {
+		return x.MaxFileBytes
+	}
+	return 0
+}
+
+type GetFileExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileExportRequest) Reset() {
+	*x = GetFileExportRequest{}
+	mi := &file_szadmin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileExportRequest) ProtoMessage() {}
+
+func (x *GetFileExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szadmin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileExportRequest.ProtoReflect.Descriptor instead.
+func (*GetFileExportRequest) Descriptor() ([]byte, []int) {
+	return file_szadmin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetFileExportRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type FileExportStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	State         FileExportState        `protobuf:"varint,2,opt,name=state,proto3,enum=szadmin.FileExportState" json:"state,omitempty"`
+	RowsWritten   int64                  `protobuf:"varint,3,opt,name=rows_written,json=rowsWritten,proto3" json:"rows_written,omitempty"`
+	BytesWritten  int64                  `protobuf:"varint,4,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	Files         []string               `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"` // Paths on the server, in the order written.
+	Started       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started,proto3" json:"started,omitempty"`
+	Finished      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=finished,proto3" json:"finished,omitempty"` // Unset while running.
+	Duration      *durationpb.Duration   `protobuf:"bytes,8,opt,name=duration,proto3" json:"duration,omitempty"` // Time so far while running.
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileExportStatus) Reset() {
+	*x = FileExportStatus{}
+	mi := &file_szadmin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileExportStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileExportStatus) ProtoMessage() {}
+
+func (x *FileExportStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_szadmin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileExportStatus.ProtoReflect.Descriptor instead.
+func (*FileExportStatus) Descriptor() ([]byte, []int) {
+	return file_szadmin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FileExportStatus) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *FileExportStatus) GetState() FileExportState {
+	if x != nil {
+		return x.State
+	}
+	return FileExportState_FILE_EXPORT_STATE_UNSPECIFIED
+}
+
+func (x *FileExportStatus) GetRowsWritten() int64 {
+	if x != nil {
+		return x.RowsWritten
+	}
+	return 0
+}
+
+func (x *FileExportStatus) GetBytesWritten() int64 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+func (x *FileExportStatus) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *FileExportStatus) GetStarted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *FileExportStatus) GetFinished() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Finished
+	}
+	return nil
+}
+
+func (x *FileExportStatus) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *FileExportStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type WatchNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchNotificationsRequest) Reset() {
+	*x = WatchNotificationsRequest{}
+	mi := &file_szadmin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchNotificationsRequest) ProtoMessage() {}
+
+func (x *WatchNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szadmin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*WatchNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_szadmin_proto_rawDescGZIP(), []int{11}
+}
+
+type Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sequence      int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"` // Increases by one with each notification the server publishes.
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`    // The notification as sent to observers, a JSON document.
+	Time          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Dropped       int64                  `protobuf:"varint,4,opt,name=dropped,proto3" json:"dropped,omitempty"` // Notifications skipped before this one because the call read too slowly.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_szadmin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_szadmin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_szadmin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Notification) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Notification) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Notification) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Notification) GetDropped() int64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+var File_szadmin_proto protoreflect.FileDescriptor
+
+const file_szadmin_proto_rawDesc = "" +
+	"\n" +
+	"\rszadmin.proto\x12\aszadmin\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x16\n" +
+	"\x14GetRedoStatusRequest\"\x12\n" +
+	"\x10PauseRedoRequest\"\x13\n" +
+	"\x11ResumeRedoRequest\"\xe8\x01\n" +
+	"\x12RedoStatusResponse\x12(\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x12.szadmin.RedoStateR\x05state\x12\x18\n" +
+	"\aworkers\x18\x02 \x01(\x05R\aworkers\x12!\n" +
+	"\fbusy_workers\x18\x03 \x01(\x05R\vbusyWorkers\x12\x1c\n" +
+	"\tprocessed\x18\x04 \x01(\x03R\tprocessed\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x03R\x06failed\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x12\x16\n" +
+	"\x06queued\x18\a \x01(\x03R\x06queued\"\x14\n" +
+	"\x12ListExportsRequest\"D\n" +
+	"\x13ListExportsResponse\x12-\n" +
+	"\aexports\x18\x01 \x03(\v2\x13.szadmin.ExportInfoR\aexports\"/\n" +
+	"\x10GetExportRequest\x12\x1b\n" +
+	"\texport_id\x18\x01 \x01(\x03R\bexportId\"\xab\x02\n" +
+	"\n" +
+	"ExportInfo\x12\x1b\n" +
+	"\texport_id\x18\x01 \x01(\x03R\bexportId\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x14\n" +
+	"\x05flags\x18\x04 \x01(\x03R\x05flags\x12&\n" +
+	"\x0fcsv_column_list\x18\x05 \x01(\tR\rcsvColumnList\x124\n" +
+	"\acreated\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x12;\n" +
+	"\vlast_access\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastAccess\x12!\n" +
+	"\frows_fetched\x18\b \x01(\x03R\vrowsFetched\"\x85\x02\n" +
+	"\x16StartFileExportRequest\x121\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x19.szadmin.FileExportFormatR\x06format\x12@\n" +
+	"\vcompression\x18\x02 \x01(\x0e2\x1e.szadmin.FileExportCompressionR\vcompression\x12\x14\n" +
+	"\x05flags\x18\x03 \x01(\x03R\x05flags\x12&\n" +
+	"\x0fcsv_column_list\x18\x04 \x01(\tR\rcsvColumnList\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12$\n" +
+	"\x0emax_file_bytes\x18\x06 \x01(\x03R\fmaxFileBytes\"-\n" +
+	"\x14GetFileExportRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\xf2\x02\n" +
+	"\x10FileExportStatus\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12.\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x18.szadmin.FileExportStateR\x05state\x12!\n" +
+	"\frows_written\x18\x03 \x01(\x03R\vrowsWritten\x12#\n" +
+	"\rbytes_written\x18\x04 \x01(\x03R\fbytesWritten\x12\x14\n" +
+	"\x05files\x18\x05 \x03(\tR\x05files\x124\n" +
+	"\astarted\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\astarted\x126\n" +
+	"\bfinished\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bfinished\x125\n" +
+	"\bduration\x18\b \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\"\x1b\n" +
+	"\x19WatchNotificationsRequest\"\x8e\x01\n" +
+	"\fNotification\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x18\n" +
+	"\adropped\x18\x04 \x01(\x03R\adropped*o\n" +
+	"\tRedoState\x12\x1a\n" +
+	"\x16REDO_STATE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13REDO_STATE_DISABLED\x10\x01\x12\x16\n" +
+	"\x12REDO_STATE_RUNNING\x10\x02\x12\x15\n" +
+	"\x11REDO_STATE_PAUSED\x10\x03*\x91\x01\n" +
+	"\x10FileExportFormat\x12\"\n" +
+	"\x1eFILE_EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19FILE_EXPORT_FORMAT_NDJSON\x10\x01\x12\x1a\n" +
+	"\x16FILE_EXPORT_FORMAT_CSV\x10\x02\x12\x1e\n" +
+	"\x1aFILE_EXPORT_FORMAT_PARQUET\x10\x03*}\n" +
+	"\x15FileExportCompression\x12 \n" +
+	"\x1cFILE_EXPORT_COMPRESSION_NONE\x10\x00\x12 \n" +
+	"\x1cFILE_EXPORT_COMPRESSION_GZIP\x10\x01\x12 \n" +
+	"\x1cFILE_EXPORT_COMPRESSION_ZSTD\x10\x02*\xb2\x01\n" +
+	"\x0fFileExportState\x12!\n" +
+	"\x1dFILE_EXPORT_STATE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19FILE_EXPORT_STATE_RUNNING\x10\x01\x12\x1f\n" +
+	"\x1bFILE_EXPORT_STATE_SUCCEEDED\x10\x02\x12\x1c\n" +
+	"\x18FILE_EXPORT_STATE_FAILED\x10\x03\x12\x1e\n" +
+	"\x1aFILE_EXPORT_STATE_CANCELED\x10\x042\xe6\x04\n" +
+	"\aSzAdmin\x12M\n" +
+	"\rGetRedoStatus\x12\x1d.szadmin.GetRedoStatusRequest\x1a\x1b.szadmin.RedoStatusResponse\"\x00\x12E\n" +
+	"\tPauseRedo\x12\x19.szadmin.PauseRedoRequest\x1a\x1b.szadmin.RedoStatusResponse\"\x00\x12G\n" +
+	"\n" +
+	"ResumeRedo\x12\x1a.szadmin.ResumeRedoRequest\x1a\x1b.szadmin.RedoStatusResponse\"\x00\x12J\n" +
+	"\vListExports\x12\x1b.szadmin.ListExportsRequest\x1a\x1c.szadmin.ListExportsResponse\"\x00\x12=\n" +
+	"\tGetExport\x12\x19.szadmin.GetExportRequest\x1a\x13.szadmin.ExportInfo\"\x00\x12O\n" +
+	"\x0fStartFileExport\x12\x1f.szadmin.StartFileExportRequest\x1a\x19.szadmin.FileExportStatus\"\x00\x12K\n" +
+	"\rGetFileExport\x12\x1d.szadmin.GetFileExportRequest\x1a\x19.szadmin.FileExportStatus\"\x00\x12S\n" +
+	"\x12WatchNotifications\x12\".szadmin.WatchNotificationsRequest\x1a\x15.szadmin.Notification\"\x000\x01B7Z5github.com/senzing-garage/serve-grpc/proto/go/szadminb\x06proto3"
+
+var (
+	file_szadmin_proto_rawDescOnce sync.Once
+	file_szadmin_proto_rawDescData []byte
+)
+
+func file_szadmin_proto_rawDescGZIP() []byte {
+	file_szadmin_proto_rawDescOnce.Do(func() {
+		file_szadmin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_szadmin_proto_rawDesc), len(file_szadmin_proto_rawDesc)))
+	})
+	return file_szadmin_proto_rawDescData
+}
+
+var file_szadmin_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_szadmin_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_szadmin_proto_goTypes = []any{
+	(RedoState)(0),                    // 0: szadmin.RedoState
+	(FileExportFormat)(0),             // 1: szadmin.FileExportFormat
+	(FileExportCompression)(0),        // 2: szadmin.FileExportCompression
+	(FileExportState)(0),              // 3: szadmin.FileExportState
+	(*GetRedoStatusRequest)(nil),      // 4: szadmin.GetRedoStatusRequest
+	(*PauseRedoRequest)(nil),          // 5: szadmin.PauseRedoRequest
+	(*ResumeRedoRequest)(nil),         // 6: szadmin.ResumeRedoRequest
+	(*RedoStatusResponse)(nil),        // 7: szadmin.RedoStatusResponse
+	(*ListExportsRequest)(nil),        // 8: szadmin.ListExportsRequest
+	(*ListExportsResponse)(nil),       // 9: szadmin.ListExportsResponse
+	(*GetExportRequest)(nil),          // 10: szadmin.GetExportRequest
+	(*ExportInfo)(nil),                // 11: szadmin.ExportInfo
+	(*StartFileExportRequest)(nil),    // 12: szadmin.StartFileExportRequest
+	(*GetFileExportRequest)(nil),      // 13: szadmin.GetFileExportRequest
+	(*FileExportStatus)(nil),          // 14: szadmin.FileExportStatus
+	(*WatchNotificationsRequest)(nil), // 15: szadmin.WatchNotificationsRequest
+	(*Notification)(nil),              // 16: szadmin.Notification
+	(*timestamppb.Timestamp)(nil),     // 17: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),       // 18: google.protobuf.Duration
+}
+var file_szadmin_proto_depIdxs = []int32{
+	0,  // 0: szadmin.RedoStatusResponse.state:type_name -> szadmin.RedoState
+	11, // 1: szadmin.ListExportsResponse.exports:type_name -> szadmin.ExportInfo
+	17, // 2: szadmin.ExportInfo.created:type_name -> google.protobuf.Timestamp
+	17, // 3: szadmin.ExportInfo.last_access:type_name -> google.protobuf.Timestamp
+	1,  // 4: szadmin.StartFileExportRequest.format:type_name -> szadmin.FileExportFormat
+	2,  // 5: szadmin.StartFileExportRequest.compression:type_name -> szadmin.FileExportCompression
+	3,  // 6: szadmin.FileExportStatus.state:type_name -> szadmin.FileExportState
+	17, // 7: szadmin.FileExportStatus.started:type_name -> google.protobuf.Timestamp
+	17, // 8: szadmin.FileExportStatus.finished:type_name -> google.protobuf.Timestamp
+	18, // 9: szadmin.FileExportStatus.duration:type_name -> google.protobuf.Duration
+	17, // 10: szadmin.Notification.time:type_name -> google.protobuf.Timestamp
+	4,  // 11: szadmin.SzAdmin.GetRedoStatus:input_type -> szadmin.GetRedoStatusRequest
+	5,  // 12: szadmin.SzAdmin.PauseRedo:input_type -> szadmin.PauseRedoRequest
+	6,  // 13: szadmin.SzAdmin.ResumeRedo:input_type -> szadmin.ResumeRedoRequest
+	8,  // 14: szadmin.SzAdmin.ListExports:input_type -> szadmin.ListExportsRequest
+	10, // 15: szadmin.SzAdmin.GetExport:input_type -> szadmin.GetExportRequest
+	12, // 16: szadmin.SzAdmin.StartFileExport:input_type -> szadmin.StartFileExportRequest
+	13, // 17: szadmin.SzAdmin.GetFileExport:input_type -> szadmin.GetFileExportRequest
+	15, // 18: szadmin.SzAdmin.WatchNotifications:input_type -> szadmin.WatchNotificationsRequest
+	7,  // 19: szadmin.SzAdmin.GetRedoStatus:output_type -> szadmin.RedoStatusResponse
+	7,  // 20: szadmin.SzAdmin.PauseRedo:output_type -> szadmin.RedoStatusResponse
+	7,  // 21: szadmin.SzAdmin.ResumeRedo:output_type -> szadmin.RedoStatusResponse
+	9,  // 22: szadmin.SzAdmin.ListExports:output_type -> szadmin.ListExportsResponse
+	11, // 23: szadmin.SzAdmin.GetExport:output_type -> szadmin.ExportInfo
+	14, // 24: szadmin.SzAdmin.StartFileExport:output_type -> szadmin.FileExportStatus
+	14, // 25: szadmin.SzAdmin.GetFileExport:output_type -> szadmin.FileExportStatus
+	16, // 26: szadmin.SzAdmin.WatchNotifications:output_type -> szadmin.Notification
+	19, // [19:27] is the sub-list for method output_type
+	11, // [11:19] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_szadmin_proto_init() }
+func file_szadmin_proto_init() {
+	if File_szadmin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_szadmin_proto_rawDesc), len(file_szadmin_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_szadmin_proto_goTypes,
+		DependencyIndexes: file_szadmin_proto_depIdxs,
+		EnumInfos:         file_szadmin_proto_enumTypes,
+		MessageInfos:      file_szadmin_proto_msgTypes,
+	}.Build()
+	File_szadmin_proto = out.File
+	file_szadmin_proto_goTypes = nil
+	file_szadmin_proto_depIdxs = nil
+}
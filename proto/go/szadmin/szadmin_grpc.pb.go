@@ -0,0 +1,413 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: szadmin.proto
+
+package szadmin
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SzAdmin_GetRedoStatus_FullMethodName      = "/szadmin.SzAdmin/GetRedoStatus"
+	SzAdmin_PauseRedo_FullMethodName          = "/szadmin.SzAdmin/PauseRedo"
+	SzAdmin_ResumeRedo_FullMethodName         = "/szadmin.SzAdmin/ResumeRedo"
+	SzAdmin_ListExports_FullMethodName        = "/szadmin.SzAdmin/ListExports"
+	SzAdmin_GetExport_FullMethodName          = "/szadmin.SzAdmin/GetExport"
+	SzAdmin_StartFileExport_FullMethodName    = "/szadmin.SzAdmin/StartFileExport"
+	SzAdmin_GetFileExport_FullMethodName      = "/szadmin.SzAdmin/GetFileExport"
+	SzAdmin_WatchNotifications_FullMethodName = "/szadmin.SzAdmin/WatchNotifications"
+)
+
+// SzAdminClient is the client API for SzAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SzAdmin lets operators inspect and control work that serve-grpc does in the background.
+// Restrict it to operators with an access policy rule for "/szadmin.SzAdmin/*".
+type SzAdminClient interface {
+	// Report the state and counters of the redo processor.
+	GetRedoStatus(ctx context.Context, in *GetRedoStatusRequest, opts ...grpc.CallOption) (*RedoStatusResponse, error)
+	// Stop taking redo records from the queue.  Records being processed are finished.
+	PauseRedo(ctx context.Context, in *PauseRedoRequest, opts ...grpc.CallOption) (*RedoStatusResponse, error)
+	// Start taking redo records from the queue again.
+	ResumeRedo(ctx context.Context, in *ResumeRedoRequest, opts ...grpc.CallOption) (*RedoStatusResponse, error)
+	// List the exports opened with SzEngine.ExportCsvEntityReport or ExportJsonEntityReport and not yet closed.
+	ListExports(ctx context.Context, in *ListExportsRequest, opts ...grpc.CallOption) (*ListExportsResponse, error)
+	// Describe one open export.
+	GetExport(ctx context.Context, in *GetExportRequest, opts ...grpc.CallOption) (*ExportInfo, error)
+	// Start writing an entity report to files in the server's export directory.  Poll GetFileExport for its progress.
+	StartFileExport(ctx context.Context, in *StartFileExportRequest, opts ...grpc.CallOption) (*FileExportStatus, error)
+	// Report the progress of a file export.
+	GetFileExport(ctx context.Context, in *GetFileExportRequest, opts ...grpc.CallOption) (*FileExportStatus, error)
+	// Stream the Senzing observer notifications published from now on.  Needs a server started with notifications enabled.
+	WatchNotifications(ctx context.Context, in *WatchNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Notification], error)
+}
+
+type szAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSzAdminClient(cc grpc.ClientConnInterface) SzAdminClient {
+	return &szAdminClient{cc}
+}
+
+func (c *szAdminClient) GetRedoStatus(ctx context.Context, in *GetRedoStatusRequest, opts ...grpc.CallOption) (*RedoStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedoStatusResponse)
+	err := c.cc.Invoke(ctx, SzAdmin_GetRedoStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szAdminClient) PauseRedo(ctx context.Context, in *PauseRedoRequest, opts ...grpc.CallOption) (*RedoStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedoStatusResponse)
+	err := c.cc.Invoke(ctx, SzAdmin_PauseRedo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szAdminClient) ResumeRedo(ctx context.Context, in *ResumeRedoRequest, opts ...grpc.CallOption) (*RedoStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedoStatusResponse)
+	err := c.cc.Invoke(ctx, SzAdmin_ResumeRedo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szAdminClient) ListExports(ctx context.Context, in *ListExportsRequest, opts ...grpc.CallOption) (*ListExportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExportsResponse)
+	err := c.cc.Invoke(ctx, SzAdmin_ListExports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szAdminClient) GetExport(ctx context.Context, in *GetExportRequest, opts ...grpc.CallOption) (*ExportInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportInfo)
+	err := c.cc.Invoke(ctx, SzAdmin_GetExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szAdminClient) StartFileExport(ctx context.Context, in *StartFileExportRequest, opts ...grpc.CallOption) (*FileExportStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileExportStatus)
+	err := c.cc.Invoke(ctx, SzAdmin_StartFileExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szAdminClient) GetFileExport(ctx context.Context, in *GetFileExportRequest, opts ...grpc.CallOption) (*FileExportStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileExportStatus)
+	err := c.cc.Invoke(ctx, SzAdmin_GetFileExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szAdminClient) WatchNotifications(ctx context.Context, in *WatchNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Notification], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SzAdmin_ServiceDesc.Streams[0], SzAdmin_WatchNotifications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchNotificationsRequest, Notification]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SzAdmin_WatchNotificationsClient = grpc.ServerStreamingClient[Notification]
+
+// SzAdminServer is the server API for SzAdmin service.
+// All implementations must embed UnimplementedSzAdminServer
+// for forward compatibility.
+//
+// SzAdmin lets operators inspect and control work that serve-grpc does in the background.
+// Restrict it to operators with an access policy rule for "/szadmin.SzAdmin/*".
+type SzAdminServer interface {
+	// Report the state and counters of the redo processor.
+	GetRedoStatus(context.Context, *GetRedoStatusRequest) (*RedoStatusResponse, error)
+	// Stop taking redo records from the queue.  Records being processed are finished.
+	PauseRedo(context.Context, *PauseRedoRequest) (*RedoStatusResponse, error)
+	// Start taking redo records from the queue again.
+	ResumeRedo(context.Context, *ResumeRedoRequest) (*RedoStatusResponse, error)
+	// List the exports opened with SzEngine.ExportCsvEntityReport or ExportJsonEntityReport and not yet closed.
+	ListExports(context.Context, *ListExportsRequest) (*ListExportsResponse, error)
+	// Describe one open export.
+	GetExport(context.Context, *GetExportRequest) (*ExportInfo, error)
+	// Start writing an entity report to files in the server's export directory.  Poll GetFileExport for its progress.
+	StartFileExport(context.Context, *StartFileExportRequest) (*FileExportStatus, error)
+	// Report the progress of a file export.
+	GetFileExport(context.Context, *GetFileExportRequest) (*FileExportStatus, error)
+	// Stream the Senzing observer notifications published from now on.  Needs a server started with notifications enabled.
+	WatchNotifications(*WatchNotificationsRequest, grpc.ServerStreamingServer[Notification]) error
+	mustEmbedUnimplementedSzAdminServer()
+}
+
+// UnimplementedSzAdminServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSzAdminServer struct{}
+
+func (UnimplementedSzAdminServer) GetRedoStatus(context.Context, *GetRedoStatusRequest) (*RedoStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRedoStatus not implemented")
+}
+func (UnimplementedSzAdminServer) PauseRedo(context.Context, *PauseRedoRequest) (*RedoStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseRedo not implemented")
+}
+func (UnimplementedSzAdminServer) ResumeRedo(context.Context, *ResumeRedoRequest) (*RedoStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeRedo not implemented")
+}
+func (UnimplementedSzAdminServer) ListExports(context.Context, *ListExportsRequest) (*ListExportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExports not implemented")
+}
+func (UnimplementedSzAdminServer) GetExport(context.Context, *GetExportRequest) (*ExportInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExport not implemented")
+}
+func (UnimplementedSzAdminServer) StartFileExport(context.Context, *StartFileExportRequest) (*FileExportStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartFileExport not implemented")
+}
+func (UnimplementedSzAdminServer) GetFileExport(context.Context, *GetFileExportRequest) (*FileExportStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileExport not implemented")
+}
+func (UnimplementedSzAdminServer) WatchNotifications(*WatchNotificationsRequest, grpc.ServerStreamingServer[Notification]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchNotifications not implemented")
+}
+func (UnimplementedSzAdminServer) mustEmbedUnimplementedSzAdminServer() {}
+func (UnimplementedSzAdminServer) testEmbeddedByValue()                 {}
+
+// UnsafeSzAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SzAdminServer will
+// result in compilation errors.
+type UnsafeSzAdminServer interface {
+	mustEmbedUnimplementedSzAdminServer()
+}
+
+func RegisterSzAdminServer(s grpc.ServiceRegistrar, srv SzAdminServer) {
+	// If the following call pancis, it indicates UnimplementedSzAdminServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SzAdmin_ServiceDesc, srv)
+}
+
+func _SzAdmin_GetRedoStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRedoStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzAdminServer).GetRedoStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzAdmin_GetRedoStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzAdminServer).GetRedoStatus(ctx, req.(*GetRedoStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzAdmin_PauseRedo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRedoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzAdminServer).PauseRedo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzAdmin_PauseRedo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzAdminServer).PauseRedo(ctx, req.(*PauseRedoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzAdmin_ResumeRedo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeRedoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzAdminServer).ResumeRedo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzAdmin_ResumeRedo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzAdminServer).ResumeRedo(ctx, req.(*ResumeRedoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzAdmin_ListExports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzAdminServer).ListExports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzAdmin_ListExports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzAdminServer).ListExports(ctx, req.(*ListExportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzAdmin_GetExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzAdminServer).GetExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzAdmin_GetExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzAdminServer).GetExport(ctx, req.(*GetExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzAdmin_StartFileExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartFileExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzAdminServer).StartFileExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzAdmin_StartFileExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzAdminServer).StartFileExport(ctx, req.(*StartFileExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzAdmin_GetFileExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzAdminServer).GetFileExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzAdmin_GetFileExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzAdminServer).GetFileExport(ctx, req.(*GetFileExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzAdmin_WatchNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SzAdminServer).WatchNotifications(m, &grpc.GenericServerStream[WatchNotificationsRequest, Notification]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SzAdmin_WatchNotificationsServer = grpc.ServerStreamingServer[Notification]
+
+// SzAdmin_ServiceDesc is the grpc.ServiceDesc for SzAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SzAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "szadmin.SzAdmin",
+	HandlerType: (*SzAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRedoStatus",
+			Handler:    _SzAdmin_GetRedoStatus_Handler,
+		},
+		{
+			MethodName: "PauseRedo",
+			Handler:    _SzAdmin_PauseRedo_Handler,
+		},
+		{
+			MethodName: "ResumeRedo",
+			Handler:    _SzAdmin_ResumeRedo_Handler,
+		},
+		{
+			MethodName: "ListExports",
+			Handler:    _SzAdmin_ListExports_Handler,
+		},
+		{
+			MethodName: "GetExport",
+			Handler:    _SzAdmin_GetExport_Handler,
+		},
+		{
+			MethodName: "StartFileExport",
+			Handler:    _SzAdmin_StartFileExport_Handler,
+		},
+		{
+			MethodName: "GetFileExport",
+			Handler:    _SzAdmin_GetFileExport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchNotifications",
+			Handler:       _SzAdmin_WatchNotifications_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "szadmin.proto",
+}
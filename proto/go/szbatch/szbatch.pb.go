@@ -0,0 +1,438 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: szbatch.proto
+
+package szbatch
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetEntitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityIds     []int64                `protobuf:"varint,1,rep,packed,name=entity_ids,json=entityIds,proto3" json:"entity_ids,omitempty"`
+	Flags         int64                  `protobuf:"varint,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEntitiesRequest) Reset() {
+	*x = GetEntitiesRequest{}
+	mi := &file_szbatch_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntitiesRequest) ProtoMessage() {}
+
+func (x *GetEntitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szbatch_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetEntitiesRequest) Descriptor() ([]byte, []int) {
+	return file_szbatch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetEntitiesRequest) GetEntityIds() []int64 {
+	if x != nil {
+		return x.EntityIds
+	}
+	return nil
+}
+
+func (x *GetEntitiesRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type RecordKey struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DataSourceCode string                 `protobuf:"bytes,1,opt,name=data_source_code,json=dataSourceCode,proto3" json:"data_source_code,omitempty"`
+	RecordId       string                 `protobuf:"bytes,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RecordKey) Reset() {
+	*x = RecordKey{}
+	mi := &file_szbatch_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordKey) ProtoMessage() {}
+
+func (x *RecordKey) ProtoReflect() protoreflect.Message {
+	mi := &file_szbatch_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordKey.ProtoReflect.Descriptor instead.
+func (*RecordKey) Descriptor() ([]byte, []int) {
+	return file_szbatch_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RecordKey) GetDataSourceCode() string {
+	if x != nil {
+		return x.DataSourceCode
+	}
+	return ""
+}
+
+func (x *RecordKey) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+type GetRecordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecordKeys    []*RecordKey           `protobuf:"bytes,1,rep,name=record_keys,json=recordKeys,proto3" json:"record_keys,omitempty"`
+	Flags         int64                  `protobuf:"varint,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecordsRequest) Reset() {
+	*x = GetRecordsRequest{}
+	mi := &file_szbatch_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecordsRequest) ProtoMessage() {}
+
+func (x *GetRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szbatch_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecordsRequest.ProtoReflect.Descriptor instead.
+func (*GetRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_szbatch_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetRecordsRequest) GetRecordKeys() []*RecordKey {
+	if x != nil {
+		return x.RecordKeys
+	}
+	return nil
+}
+
+func (x *GetRecordsRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type SearchByAttributesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attributes    []string               `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"` // One JSON document of search attributes per search.
+	SearchProfile string                 `protobuf:"bytes,2,opt,name=search_profile,json=searchProfile,proto3" json:"search_profile,omitempty"`
+	Flags         int64                  `protobuf:"varint,3,opt,name=flags,proto3" json:"flags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchByAttributesRequest) Reset() {
+	*x = SearchByAttributesRequest{}
+	mi := &file_szbatch_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchByAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchByAttributesRequest) ProtoMessage() {}
+
+func (x *SearchByAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szbatch_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchByAttributesRequest.ProtoReflect.Descriptor instead.
+func (*SearchByAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_szbatch_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchByAttributesRequest) GetAttributes() []string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *SearchByAttributesRequest) GetSearchProfile() string {
+	if x != nil {
+		return x.SearchProfile
+	}
+	return ""
+}
+
+func (x *SearchByAttributesRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type BatchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`                         // SDK result JSON; empty if the item failed.
+	ErrorCode     int32                  `protobuf:"varint,2,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"` // gRPC status code of the error; 0 if the item succeeded.
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchResult) Reset() {
+	*x = BatchResult{}
+	mi := &file_szbatch_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResult) ProtoMessage() {}
+
+func (x *BatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_szbatch_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResult.ProtoReflect.Descriptor instead.
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return file_szbatch_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchResult) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *BatchResult) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *BatchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchResult         `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // One result per item, in the order of the request.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchResponse) Reset() {
+	*x = BatchResponse{}
+	mi := &file_szbatch_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResponse) ProtoMessage() {}
+
+func (x *BatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szbatch_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResponse.ProtoReflect.Descriptor instead.
+func (*BatchResponse) Descriptor() ([]byte, []int) {
+	return file_szbatch_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchResponse) GetResults() []*BatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_szbatch_proto protoreflect.FileDescriptor
+
+const file_szbatch_proto_rawDesc = "" +
+	"\n" +
+	"\rszbatch.proto\x12\aszbatch\"I\n" +
+	"\x12GetEntitiesRequest\x12\x1d\n" +
+	"\n" +
+	"entity_ids\x18\x01 \x03(\x03R\tentityIds\x12\x14\n" +
+	"\x05flags\x18\x02 \x01(\x03R\x05flags\"R\n" +
+	"\tRecordKey\x12(\n" +
+	"\x10data_source_code\x18\x01 \x01(\tR\x0edataSourceCode\x12\x1b\n" +
+	"\trecord_id\x18\x02 \x01(\tR\brecordId\"^\n" +
+	"\x11GetRecordsRequest\x123\n" +
+	"\vrecord_keys\x18\x01 \x03(\v2\x12.szbatch.RecordKeyR\n" +
+	"recordKeys\x12\x14\n" +
+	"\x05flags\x18\x02 \x01(\x03R\x05flags\"x\n" +
+	"\x19SearchByAttributesRequest\x12\x1e\n" +
+	"\n" +
+	"attributes\x18\x01 \x03(\tR\n" +
+	"attributes\x12%\n" +
+	"\x0esearch_profile\x18\x02 \x01(\tR\rsearchProfile\x12\x14\n" +
+	"\x05flags\x18\x03 \x01(\x03R\x05flags\"Z\n" +
+	"\vBatchResult\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\tR\x06result\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x02 \x01(\x05R\terrorCode\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"?\n" +
+	"\rBatchResponse\x12.\n" +
+	"\aresults\x18\x01 \x03(\v2\x14.szbatch.BatchResultR\aresults2\xe7\x01\n" +
+	"\aSzBatch\x12D\n" +
+	"\vGetEntities\x12\x1b.szbatch.GetEntitiesRequest\x1a\x16.szbatch.BatchResponse\"\x00\x12B\n" +
+	"\n" +
+	"GetRecords\x12\x1a.szbatch.GetRecordsRequest\x1a\x16.szbatch.BatchResponse\"\x00\x12R\n" +
+	"\x12SearchByAttributes\x12\".szbatch.SearchByAttributesRequest\x1a\x16.szbatch.BatchResponse\"\x00B7Z5github.com/senzing-garage/serve-grpc/proto/go/szbatchb\x06proto3"
+
+var (
+	file_szbatch_proto_rawDescOnce sync.Once
+	file_szbatch_proto_rawDescData []byte
+)
+
+func file_szbatch_proto_rawDescGZIP() []byte {
+	file_szbatch_proto_rawDescOnce.Do(func() {
+		file_szbatch_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_szbatch_proto_rawDesc), len(file_szbatch_proto_rawDesc)))
+	})
+	return file_szbatch_proto_rawDescData
+}
+
+var file_szbatch_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_szbatch_proto_goTypes = []any{
+	(*GetEntitiesRequest)(nil),        // 0: szbatch.GetEntitiesRequest
+	(*RecordKey)(nil),                 // 1: szbatch.RecordKey
+	(*GetRecordsRequest)(nil),         // 2: szbatch.GetRecordsRequest
+	(*SearchByAttributesRequest)(nil), // 3: szbatch.SearchByAttributesRequest
+	(*BatchResult)(nil),               // 4: szbatch.BatchResult
+	(*BatchResponse)(nil),             // 5: szbatch.BatchResponse
+}
+var file_szbatch_proto_depIdxs = []int32{
+	1, // 0: szbatch.GetRecordsRequest.record_keys:type_name -> szbatch.RecordKey
+	4, // 1: szbatch.BatchResponse.results:type_name -> szbatch.BatchResult
+	0, // 2: szbatch.SzBatch.GetEntities:input_type -> szbatch.GetEntitiesRequest
+	2, // 3: szbatch.SzBatch.GetRecords:input_type -> szbatch.GetRecordsRequest
+	3, // 4: szbatch.SzBatch.SearchByAttributes:input_type -> szbatch.SearchByAttributesRequest
+	5, // 5: szbatch.SzBatch.GetEntities:output_type -> szbatch.BatchResponse
+	5, // 6: szbatch.SzBatch.GetRecords:output_type -> szbatch.BatchResponse
+	5, // 7: szbatch.SzBatch.SearchByAttributes:output_type -> szbatch.BatchResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_szbatch_proto_init() }
+func file_szbatch_proto_init() {
+	if File_szbatch_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_szbatch_proto_rawDesc), len(file_szbatch_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_szbatch_proto_goTypes,
+		DependencyIndexes: file_szbatch_proto_depIdxs,
+		MessageInfos:      file_szbatch_proto_msgTypes,
+	}.Build()
+	File_szbatch_proto = out.File
+	file_szbatch_proto_goTypes = nil
+	file_szbatch_proto_depIdxs = nil
+}
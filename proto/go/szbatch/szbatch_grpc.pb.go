@@ -0,0 +1,211 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: szbatch.proto
+
+package szbatch
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SzBatch_GetEntities_FullMethodName        = "/szbatch.SzBatch/GetEntities"
+	SzBatch_GetRecords_FullMethodName         = "/szbatch.SzBatch/GetRecords"
+	SzBatch_SearchByAttributes_FullMethodName = "/szbatch.SzBatch/SearchByAttributes"
+)
+
+// SzBatchClient is the client API for SzBatch service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SzBatch makes many SzEngine reads in one RPC, so that a client rendering a page of entities pays for one round trip.
+// Items are read concurrently by a bounded worker pool, but results are returned in the order of the request.
+// A failing item is reported in its result and does not fail the call.
+type SzBatchClient interface {
+	// Get each entity, as SzEngine GetEntityByEntityId would.
+	GetEntities(ctx context.Context, in *GetEntitiesRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	// Get each record, as SzEngine GetRecord would.
+	GetRecords(ctx context.Context, in *GetRecordsRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	// Search for each set of attributes, as SzEngine SearchByAttributes would.
+	SearchByAttributes(ctx context.Context, in *SearchByAttributesRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+}
+
+type szBatchClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSzBatchClient(cc grpc.ClientConnInterface) SzBatchClient {
+	return &szBatchClient{cc}
+}
+
+func (c *szBatchClient) GetEntities(ctx context.Context, in *GetEntitiesRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, SzBatch_GetEntities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szBatchClient) GetRecords(ctx context.Context, in *GetRecordsRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, SzBatch_GetRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szBatchClient) SearchByAttributes(ctx context.Context, in *SearchByAttributesRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, SzBatch_SearchByAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SzBatchServer is the server API for SzBatch service.
+// All implementations must embed UnimplementedSzBatchServer
+// for forward compatibility.
+//
+// SzBatch makes many SzEngine reads in one RPC, so that a client rendering a page of entities pays for one round trip.
+// Items are read concurrently by a bounded worker pool, but results are returned in the order of the request.
+// A failing item is reported in its result and does not fail the call.
+type SzBatchServer interface {
+	// Get each entity, as SzEngine GetEntityByEntityId would.
+	GetEntities(context.Context, *GetEntitiesRequest) (*BatchResponse, error)
+	// Get each record, as SzEngine GetRecord would.
+	GetRecords(context.Context, *GetRecordsRequest) (*BatchResponse, error)
+	// Search for each set of attributes, as SzEngine SearchByAttributes would.
+	SearchByAttributes(context.Context, *SearchByAttributesRequest) (*BatchResponse, error)
+	mustEmbedUnimplementedSzBatchServer()
+}
+
+// UnimplementedSzBatchServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSzBatchServer struct{}
+
+func (UnimplementedSzBatchServer) GetEntities(context.Context, *GetEntitiesRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntities not implemented")
+}
+func (UnimplementedSzBatchServer) GetRecords(context.Context, *GetRecordsRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecords not implemented")
+}
+func (UnimplementedSzBatchServer) SearchByAttributes(context.Context, *SearchByAttributesRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchByAttributes not implemented")
+}
+func (UnimplementedSzBatchServer) mustEmbedUnimplementedSzBatchServer() {}
+func (UnimplementedSzBatchServer) testEmbeddedByValue()                 {}
+
+// UnsafeSzBatchServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SzBatchServer will
+// result in compilation errors.
+type UnsafeSzBatchServer interface {
+	mustEmbedUnimplementedSzBatchServer()
+}
+
+func RegisterSzBatchServer(s grpc.ServiceRegistrar, srv SzBatchServer) {
+	// If the following call pancis, it indicates UnimplementedSzBatchServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SzBatch_ServiceDesc, srv)
+}
+
+func _SzBatch_GetEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzBatchServer).GetEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzBatch_GetEntities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzBatchServer).GetEntities(ctx, req.(*GetEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzBatch_GetRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzBatchServer).GetRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzBatch_GetRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzBatchServer).GetRecords(ctx, req.(*GetRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzBatch_SearchByAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchByAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzBatchServer).SearchByAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzBatch_SearchByAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzBatchServer).SearchByAttributes(ctx, req.(*SearchByAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SzBatch_ServiceDesc is the grpc.ServiceDesc for SzBatch service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SzBatch_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "szbatch.SzBatch",
+	HandlerType: (*SzBatchServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetEntities",
+			Handler:    _SzBatch_GetEntities_Handler,
+		},
+		{
+			MethodName: "GetRecords",
+			Handler:    _SzBatch_GetRecords_Handler,
+		},
+		{
+			MethodName: "SearchByAttributes",
+			Handler:    _SzBatch_SearchByAttributes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "szbatch.proto",
+}
@@ -0,0 +1,453 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: szbulk.proto
+
+package szbulk
+
+import (
+	szengine "github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BulkRecordResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Sequence       int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"` // Position of the request in the client stream, starting at 0.
+	DataSourceCode string                 `protobuf:"bytes,2,opt,name=data_source_code,json=dataSourceCode,proto3" json:"data_source_code,omitempty"`
+	RecordId       string                 `protobuf:"bytes,3,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Result         string                 `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`                         // SDK result, e.g. the WITH_INFO JSON.
+	ErrorCode      int32                  `protobuf:"varint,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"` // gRPC status code of the error; 0 if the record succeeded.
+	Error          string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	EntityId       int64                  `protobuf:"varint,7,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"` // Set for ReevaluateEntities.
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BulkRecordResponse) Reset() {
+	*x = BulkRecordResponse{}
+	mi := &file_szbulk_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkRecordResponse) ProtoMessage() {}
+
+func (x *BulkRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szbulk_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkRecordResponse.ProtoReflect.Descriptor instead.
+func (*BulkRecordResponse) Descriptor() ([]byte, []int) {
+	return file_szbulk_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BulkRecordResponse) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *BulkRecordResponse) GetDataSourceCode() string {
+	if x != nil {
+		return x.DataSourceCode
+	}
+	return ""
+}
+
+func (x *BulkRecordResponse) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *BulkRecordResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *BulkRecordResponse) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *BulkRecordResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BulkRecordResponse) GetEntityId() int64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+type BulkSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Received      int64                  `protobuf:"varint,1,opt,name=received,proto3" json:"received,omitempty"`
+	Succeeded     int64                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int64                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Failures      []*BulkRecordResponse  `protobuf:"bytes,4,rep,name=failures,proto3" json:"failures,omitempty"` // The first failures, up to a server limit.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkSummaryResponse) Reset() {
+	*x = BulkSummaryResponse{}
+	mi := &file_szbulk_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkSummaryResponse) ProtoMessage() {}
+
+func (x *BulkSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szbulk_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkSummaryResponse.ProtoReflect.Descriptor instead.
+func (*BulkSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_szbulk_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BulkSummaryResponse) GetReceived() int64 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *BulkSummaryResponse) GetSucceeded() int64 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BulkSummaryResponse) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BulkSummaryResponse) GetFailures() []*BulkRecordResponse {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+// Counts of the items a stream has completed.  succeeded + failed + not_found = completed.
+type BulkProgress struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Completed        int64                  `protobuf:"varint,1,opt,name=completed,proto3" json:"completed,omitempty"`
+	Succeeded        int64                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed           int64                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	NotFound         int64                  `protobuf:"varint,4,opt,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`                         // Items whose record or entity does not exist.
+	AffectedEntities int64                  `protobuf:"varint,5,opt,name=affected_entities,json=affectedEntities,proto3" json:"affected_entities,omitempty"` // Distinct entities in AFFECTED_ENTITIES so far, for requests with SZ_WITH_INFO.
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BulkProgress) Reset() {
+	*x = BulkProgress{}
+	mi := &file_szbulk_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkProgress) ProtoMessage() {}
+
+func (x *BulkProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_szbulk_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkProgress.ProtoReflect.Descriptor instead.
+func (*BulkProgress) Descriptor() ([]byte, []int) {
+	return file_szbulk_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BulkProgress) GetCompleted() int64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *BulkProgress) GetSucceeded() int64 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BulkProgress) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BulkProgress) GetNotFound() int64 {
+	if x != nil {
+		return x.NotFound
+	}
+	return 0
+}
+
+func (x *BulkProgress) GetAffectedEntities() int64 {
+	if x != nil {
+		return x.AffectedEntities
+	}
+	return 0
+}
+
+// A result for one item, or progress.  Progress is sent every few items and once more at the end of the stream.
+type BulkProgressResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Response:
+	//
+	//	*BulkProgressResponse_Result
+	//	*BulkProgressResponse_Progress
+	Response      isBulkProgressResponse_Response `protobuf_oneof:"response"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkProgressResponse) Reset() {
+	*x = BulkProgressResponse{}
+	mi := &file_szbulk_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkProgressResponse) ProtoMessage() {}
+
+func (x *BulkProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szbulk_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkProgressResponse.ProtoReflect.Descriptor instead.
+func (*BulkProgressResponse) Descriptor() ([]byte, []int) {
+	return file_szbulk_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BulkProgressResponse) GetResponse() isBulkProgressResponse_Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *BulkProgressResponse) GetResult() *BulkRecordResponse {
+	if x != nil {
+		if x, ok := x.Response.(*BulkProgressResponse_Result); ok {
+			return x.Result
+		}
+	}
+	return nil
+}
+
+func (x *BulkProgressResponse) GetProgress() *BulkProgress {
+	if x != nil {
+		if x, ok := x.Response.(*BulkProgressResponse_Progress); ok {
+			return x.Progress
+		}
+	}
+	return nil
+}
+
+type isBulkProgressResponse_Response interface {
+	isBulkProgressResponse_Response()
+}
+
+type BulkProgressResponse_Result struct {
+	Result *BulkRecordResponse `protobuf:"bytes,1,opt,name=result,proto3,oneof"`
+}
+
+type BulkProgressResponse_Progress struct {
+	Progress *BulkProgress `protobuf:"bytes,2,opt,name=progress,proto3,oneof"`
+}
+
+func (*BulkProgressResponse_Result) isBulkProgressResponse_Response() {}
+
+func (*BulkProgressResponse_Progress) isBulkProgressResponse_Response() {}
+
+var File_szbulk_proto protoreflect.FileDescriptor
+
+const file_szbulk_proto_rawDesc = "" +
+	"\n" +
+	"\fszbulk.proto\x12\x06szbulk\x1a\x0eszengine.proto\"\xe1\x01\n" +
+	"\x12BulkRecordResponse\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12(\n" +
+	"\x10data_source_code\x18\x02 \x01(\tR\x0edataSourceCode\x12\x1b\n" +
+	"\trecord_id\x18\x03 \x01(\tR\brecordId\x12\x16\n" +
+	"\x06result\x18\x04 \x01(\tR\x06result\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x05 \x01(\x05R\terrorCode\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x12\x1b\n" +
+	"\tentity_id\x18\a \x01(\x03R\bentityId\"\x9f\x01\n" +
+	"\x13BulkSummaryResponse\x12\x1a\n" +
+	"\breceived\x18\x01 \x01(\x03R\breceived\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x03R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x03R\x06failed\x126\n" +
+	"\bfailures\x18\x04 \x03(\v2\x1a.szbulk.BulkRecordResponseR\bfailures\"\xac\x01\n" +
+	"\fBulkProgress\x12\x1c\n" +
+	"\tcompleted\x18\x01 \x01(\x03R\tcompleted\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x03R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x03R\x06failed\x12\x1b\n" +
+	"\tnot_found\x18\x04 \x01(\x03R\bnotFound\x12+\n" +
+	"\x11affected_entities\x18\x05 \x01(\x03R\x10affectedEntities\"\x8c\x01\n" +
+	"\x14BulkProgressResponse\x124\n" +
+	"\x06result\x18\x01 \x01(\v2\x1a.szbulk.BulkRecordResponseH\x00R\x06result\x122\n" +
+	"\bprogress\x18\x02 \x01(\v2\x14.szbulk.BulkProgressH\x00R\bprogressB\n" +
+	"\n" +
+	"\bresponse2\xad\x03\n" +
+	"\x06SzBulk\x12J\n" +
+	"\n" +
+	"AddRecords\x12\x1a.szengine.AddRecordRequest\x1a\x1a.szbulk.BulkRecordResponse\"\x00(\x010\x01\x12J\n" +
+	"\vLoadRecords\x12\x1a.szengine.AddRecordRequest\x1a\x1b.szbulk.BulkSummaryResponse\"\x00(\x01\x12R\n" +
+	"\rDeleteRecords\x12\x1d.szengine.DeleteRecordRequest\x1a\x1c.szbulk.BulkProgressResponse\"\x00(\x010\x01\x12[\n" +
+	"\x12ReevaluateEntities\x12!.szengine.ReevaluateEntityRequest\x1a\x1c.szbulk.BulkProgressResponse\"\x00(\x010\x01\x12Z\n" +
+	"\x11ReevaluateRecords\x12!.szengine.ReevaluateRecordRequest\x1a\x1c.szbulk.BulkProgressResponse\"\x00(\x010\x01B6Z4github.com/senzing-garage/serve-grpc/proto/go/szbulkb\x06proto3"
+
+var (
+	file_szbulk_proto_rawDescOnce sync.Once
+	file_szbulk_proto_rawDescData []byte
+)
+
+func file_szbulk_proto_rawDescGZIP() []byte {
+	file_szbulk_proto_rawDescOnce.Do(func() {
+		file_szbulk_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_szbulk_proto_rawDesc), len(file_szbulk_proto_rawDesc)))
+	})
+	return file_szbulk_proto_rawDescData
+}
+
+var file_szbulk_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_szbulk_proto_goTypes = []any{
+	(*BulkRecordResponse)(nil),               // 0: szbulk.BulkRecordResponse
+	(*BulkSummaryResponse)(nil),              // 1: szbulk.BulkSummaryResponse
+	(*BulkProgress)(nil),                     // 2: szbulk.BulkProgress
+	(*BulkProgressResponse)(nil),             // 3: szbulk.BulkProgressResponse
+	(*szengine.AddRecordRequest)(nil),        // 4: szengine.AddRecordRequest
+	(*szengine.DeleteRecordRequest)(nil),     // 5: szengine.DeleteRecordRequest
+	(*szengine.ReevaluateEntityRequest)(nil), // 6: szengine.ReevaluateEntityRequest
+	(*szengine.ReevaluateRecordRequest)(nil), // 7: szengine.ReevaluateRecordRequest
+}
+var file_szbulk_proto_depIdxs = []int32{
+	0, // 0: szbulk.BulkSummaryResponse.failures:type_name -> szbulk.BulkRecordResponse
+	0, // 1: szbulk.BulkProgressResponse.result:type_name -> szbulk.BulkRecordResponse
+	2, // 2: szbulk.BulkProgressResponse.progress:type_name -> szbulk.BulkProgress
+	4, // 3: szbulk.SzBulk.AddRecords:input_type -> szengine.AddRecordRequest
+	4, // 4: szbulk.SzBulk.LoadRecords:input_type -> szengine.AddRecordRequest
+	5, // 5: szbulk.SzBulk.DeleteRecords:input_type -> szengine.DeleteRecordRequest
+	6, // 6: szbulk.SzBulk.ReevaluateEntities:input_type -> szengine.ReevaluateEntityRequest
+	7, // 7: szbulk.SzBulk.ReevaluateRecords:input_type -> szengine.ReevaluateRecordRequest
+	0, // 8: szbulk.SzBulk.AddRecords:output_type -> szbulk.BulkRecordResponse
+	1, // 9: szbulk.SzBulk.LoadRecords:output_type -> szbulk.BulkSummaryResponse
+	3, // 10: szbulk.SzBulk.DeleteRecords:output_type -> szbulk.BulkProgressResponse
+	3, // 11: szbulk.SzBulk.ReevaluateEntities:output_type -> szbulk.BulkProgressResponse
+	3, // 12: szbulk.SzBulk.ReevaluateRecords:output_type -> szbulk.BulkProgressResponse
+	8, // [8:13] is the sub-list for method output_type
+	3, // [3:8] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_szbulk_proto_init() }
+func file_szbulk_proto_init() {
+	if File_szbulk_proto != nil {
+		return
+	}
+	file_szbulk_proto_msgTypes[3].OneofWrappers = []any{
+		(*BulkProgressResponse_Result)(nil),
+		(*BulkProgressResponse_Progress)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_szbulk_proto_rawDesc), len(file_szbulk_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_szbulk_proto_goTypes,
+		DependencyIndexes: file_szbulk_proto_depIdxs,
+		MessageInfos:      file_szbulk_proto_msgTypes,
+	}.Build()
+	File_szbulk_proto = out.File
+	file_szbulk_proto_goTypes = nil
+	file_szbulk_proto_depIdxs = nil
+}
@@ -0,0 +1,261 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: szbulk.proto
+
+package szbulk
+
+import (
+	context "context"
+	szengine "github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SzBulk_AddRecords_FullMethodName         = "/szbulk.SzBulk/AddRecords"
+	SzBulk_LoadRecords_FullMethodName        = "/szbulk.SzBulk/LoadRecords"
+	SzBulk_DeleteRecords_FullMethodName      = "/szbulk.SzBulk/DeleteRecords"
+	SzBulk_ReevaluateEntities_FullMethodName = "/szbulk.SzBulk/ReevaluateEntities"
+	SzBulk_ReevaluateRecords_FullMethodName  = "/szbulk.SzBulk/ReevaluateRecords"
+)
+
+// SzBulkClient is the client API for SzBulk service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SzBulk streams many SzEngine calls through one RPC.
+// Requests are processed concurrently by a bounded worker pool, so results arrive in completion order.
+// A failing record is reported in its result and does not end the stream.
+type SzBulkClient interface {
+	// Add each record sent and stream back its result.
+	AddRecords(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[szengine.AddRecordRequest, BulkRecordResponse], error)
+	// Add each record sent and return a summary when the client closes its stream.
+	LoadRecords(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[szengine.AddRecordRequest, BulkSummaryResponse], error)
+	// Delete each record sent, streaming back its result and periodic progress.
+	DeleteRecords(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[szengine.DeleteRecordRequest, BulkProgressResponse], error)
+	// Reevaluate each entity sent, streaming back its result and periodic progress.
+	ReevaluateEntities(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[szengine.ReevaluateEntityRequest, BulkProgressResponse], error)
+	// Reevaluate each record sent, streaming back its result and periodic progress.
+	ReevaluateRecords(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[szengine.ReevaluateRecordRequest, BulkProgressResponse], error)
+}
+
+type szBulkClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSzBulkClient(cc grpc.ClientConnInterface) SzBulkClient {
+	return &szBulkClient{cc}
+}
+
+func (c *szBulkClient) AddRecords(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[szengine.AddRecordRequest, BulkRecordResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SzBulk_ServiceDesc.Streams[0], SzBulk_AddRecords_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[szengine.AddRecordRequest, BulkRecordResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SzBulk_AddRecordsClient = grpc.BidiStreamingClient[szengine.AddRecordRequest, BulkRecordResponse]
+
+func (c *szBulkClient) LoadRecords(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[szengine.AddRecordRequest, BulkSummaryResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SzBulk_ServiceDesc.Streams[1], SzBulk_LoadRecords_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[szengine.AddRecordRequest, BulkSummaryResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SzBulk_LoadRecordsClient = grpc.ClientStreamingClient[szengine.AddRecordRequest, BulkSummaryResponse]
+
+func (c *szBulkClient) DeleteRecords(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[szengine.DeleteRecordRequest, BulkProgressResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SzBulk_ServiceDesc.Streams[2], SzBulk_DeleteRecords_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[szengine.DeleteRecordRequest, BulkProgressResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SzBulk_DeleteRecordsClient = grpc.BidiStreamingClient[szengine.DeleteRecordRequest, BulkProgressResponse]
+
+func (c *szBulkClient) ReevaluateEntities(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[szengine.ReevaluateEntityRequest, BulkProgressResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SzBulk_ServiceDesc.Streams[3], SzBulk_ReevaluateEntities_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[szengine.ReevaluateEntityRequest, BulkProgressResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SzBulk_ReevaluateEntitiesClient = grpc.BidiStreamingClient[szengine.ReevaluateEntityRequest, BulkProgressResponse]
+
+func (c *szBulkClient) ReevaluateRecords(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[szengine.ReevaluateRecordRequest, BulkProgressResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SzBulk_ServiceDesc.Streams[4], SzBulk_ReevaluateRecords_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[szengine.ReevaluateRecordRequest, BulkProgressResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SzBulk_ReevaluateRecordsClient = grpc.BidiStreamingClient[szengine.ReevaluateRecordRequest, BulkProgressResponse]
+
+// SzBulkServer is the server API for SzBulk service.
+// All implementations must embed UnimplementedSzBulkServer
+// for forward compatibility.
+//
+// SzBulk streams many SzEngine calls through one RPC.
+// Requests are processed concurrently by a bounded worker pool, so results arrive in completion order.
+// A failing record is reported in its result and does not end the stream.
+type SzBulkServer interface {
+	// Add each record sent and stream back its result.
+	AddRecords(grpc.BidiStreamingServer[szengine.AddRecordRequest, BulkRecordResponse]) error
+	// Add each record sent and return a summary when the client closes its stream.
+	LoadRecords(grpc.ClientStreamingServer[szengine.AddRecordRequest, BulkSummaryResponse]) error
+	// Delete each record sent, streaming back its result and periodic progress.
+	DeleteRecords(grpc.BidiStreamingServer[szengine.DeleteRecordRequest, BulkProgressResponse]) error
+	// Reevaluate each entity sent, streaming back its result and periodic progress.
+	ReevaluateEntities(grpc.BidiStreamingServer[szengine.ReevaluateEntityRequest, BulkProgressResponse]) error
+	// Reevaluate each record sent, streaming back its result and periodic progress.
+	ReevaluateRecords(grpc.BidiStreamingServer[szengine.ReevaluateRecordRequest, BulkProgressResponse]) error
+	mustEmbedUnimplementedSzBulkServer()
+}
+
+// UnimplementedSzBulkServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSzBulkServer struct{}
+
+func (UnimplementedSzBulkServer) AddRecords(grpc.BidiStreamingServer[szengine.AddRecordRequest, BulkRecordResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method AddRecords not implemented")
+}
+func (UnimplementedSzBulkServer) LoadRecords(grpc.ClientStreamingServer[szengine.AddRecordRequest, BulkSummaryResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method LoadRecords not implemented")
+}
+func (UnimplementedSzBulkServer) DeleteRecords(grpc.BidiStreamingServer[szengine.DeleteRecordRequest, BulkProgressResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DeleteRecords not implemented")
+}
+func (UnimplementedSzBulkServer) ReevaluateEntities(grpc.BidiStreamingServer[szengine.ReevaluateEntityRequest, BulkProgressResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ReevaluateEntities not implemented")
+}
+func (UnimplementedSzBulkServer) ReevaluateRecords(grpc.BidiStreamingServer[szengine.ReevaluateRecordRequest, BulkProgressResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ReevaluateRecords not implemented")
+}
+func (UnimplementedSzBulkServer) mustEmbedUnimplementedSzBulkServer() {}
+func (UnimplementedSzBulkServer) testEmbeddedByValue()                {}
+
+// UnsafeSzBulkServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SzBulkServer will
+// result in compilation errors.
+type UnsafeSzBulkServer interface {
+	mustEmbedUnimplementedSzBulkServer()
+}
+
+func RegisterSzBulkServer(s grpc.ServiceRegistrar, srv SzBulkServer) {
+	// If the following call pancis, it indicates UnimplementedSzBulkServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SzBulk_ServiceDesc, srv)
+}
+
+func _SzBulk_AddRecords_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SzBulkServer).AddRecords(&grpc.GenericServerStream[szengine.AddRecordRequest, BulkRecordResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SzBulk_AddRecordsServer = grpc.BidiStreamingServer[szengine.AddRecordRequest, BulkRecordResponse]
+
+func _SzBulk_LoadRecords_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SzBulkServer).LoadRecords(&grpc.GenericServerStream[szengine.AddRecordRequest, BulkSummaryResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SzBulk_LoadRecordsServer = grpc.ClientStreamingServer[szengine.AddRecordRequest, BulkSummaryResponse]
+
+func _SzBulk_DeleteRecords_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SzBulkServer).DeleteRecords(&grpc.GenericServerStream[szengine.DeleteRecordRequest, BulkProgressResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SzBulk_DeleteRecordsServer = grpc.BidiStreamingServer[szengine.DeleteRecordRequest, BulkProgressResponse]
+
+func _SzBulk_ReevaluateEntities_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SzBulkServer).ReevaluateEntities(&grpc.GenericServerStream[szengine.ReevaluateEntityRequest, BulkProgressResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SzBulk_ReevaluateEntitiesServer = grpc.BidiStreamingServer[szengine.ReevaluateEntityRequest, BulkProgressResponse]
+
+func _SzBulk_ReevaluateRecords_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SzBulkServer).ReevaluateRecords(&grpc.GenericServerStream[szengine.ReevaluateRecordRequest, BulkProgressResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SzBulk_ReevaluateRecordsServer = grpc.BidiStreamingServer[szengine.ReevaluateRecordRequest, BulkProgressResponse]
+
+// SzBulk_ServiceDesc is the grpc.ServiceDesc for SzBulk service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SzBulk_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "szbulk.SzBulk",
+	HandlerType: (*SzBulkServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AddRecords",
+			Handler:       _SzBulk_AddRecords_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "LoadRecords",
+			Handler:       _SzBulk_LoadRecords_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DeleteRecords",
+			Handler:       _SzBulk_DeleteRecords_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReevaluateEntities",
+			Handler:       _SzBulk_ReevaluateEntities_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReevaluateRecords",
+			Handler:       _SzBulk_ReevaluateRecords_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "szbulk.proto",
+}
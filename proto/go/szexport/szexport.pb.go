@@ -0,0 +1,380 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: szexport.proto
+
+package szexport
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StreamCsvEntityReportRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CsvColumnList      string                 `protobuf:"bytes,1,opt,name=csv_column_list,json=csvColumnList,proto3" json:"csv_column_list,omitempty"`
+	Flags              int64                  `protobuf:"varint,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	ResumeToken        string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`                       // Empty to start from the first row.
+	CheckpointInterval int64                  `protobuf:"varint,4,opt,name=checkpoint_interval,json=checkpointInterval,proto3" json:"checkpoint_interval,omitempty"` // Rows between resume tokens; 0 uses the server default.
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *StreamCsvEntityReportRequest) Reset() {
+	*x = StreamCsvEntityReportRequest{}
+	mi := &file_szexport_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamCsvEntityReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamCsvEntityReportRequest) ProtoMessage() {}
+
+func (x *StreamCsvEntityReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szexport_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamCsvEntityReportRequest.ProtoReflect.Descriptor instead.
+func (*StreamCsvEntityReportRequest) Descriptor() ([]byte, []int) {
+	return file_szexport_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StreamCsvEntityReportRequest) GetCsvColumnList() string {
+	if x != nil {
+		return x.CsvColumnList
+	}
+	return ""
+}
+
+func (x *StreamCsvEntityReportRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *StreamCsvEntityReportRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *StreamCsvEntityReportRequest) GetCheckpointInterval() int64 {
+	if x != nil {
+		return x.CheckpointInterval
+	}
+	return 0
+}
+
+type StreamJsonEntityReportRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Flags              int64                  `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	ResumeToken        string                 `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`                       // Empty to start from the first row.
+	CheckpointInterval int64                  `protobuf:"varint,3,opt,name=checkpoint_interval,json=checkpointInterval,proto3" json:"checkpoint_interval,omitempty"` // Rows between resume tokens; 0 uses the server default.
+	Filter             *EntityFilter          `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`                                                    // Unset to send every entity.
+	Fields             []string               `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`                                                    // Paths of the values to send from each row, e.g. "RESOLVED_ENTITY.RECORDS[*].RECORD_ID"; empty sends whole rows.
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *StreamJsonEntityReportRequest) Reset() {
+	*x = StreamJsonEntityReportRequest{}
+	mi := &file_szexport_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamJsonEntityReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamJsonEntityReportRequest) ProtoMessage() {}
+
+func (x *StreamJsonEntityReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szexport_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamJsonEntityReportRequest.ProtoReflect.Descriptor instead.
+func (*StreamJsonEntityReportRequest) Descriptor() ([]byte, []int) {
+	return file_szexport_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StreamJsonEntityReportRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *StreamJsonEntityReportRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *StreamJsonEntityReportRequest) GetCheckpointInterval() int64 {
+	if x != nil {
+		return x.CheckpointInterval
+	}
+	return 0
+}
+
+func (x *StreamJsonEntityReportRequest) GetFilter() *EntityFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *StreamJsonEntityReportRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// Entities must match every field that is set.
+type EntityFilter struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DataSources    []string               `protobuf:"bytes,1,rep,name=data_sources,json=dataSources,proto3" json:"data_sources,omitempty"`             // Entities with a record from any of these data sources.
+	MinRecordCount int64                  `protobuf:"varint,2,opt,name=min_record_count,json=minRecordCount,proto3" json:"min_record_count,omitempty"` // Entities with at least this many records.
+	MinEntityId    int64                  `protobuf:"varint,3,opt,name=min_entity_id,json=minEntityId,proto3" json:"min_entity_id,omitempty"`          // Entities with ENTITY_ID >= min_entity_id.
+	MaxEntityId    int64                  `protobuf:"varint,4,opt,name=max_entity_id,json=maxEntityId,proto3" json:"max_entity_id,omitempty"`          // Entities with ENTITY_ID <= max_entity_id; 0 for no maximum.
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EntityFilter) Reset() {
+	*x = EntityFilter{}
+	mi := &file_szexport_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityFilter) ProtoMessage() {}
+
+func (x *EntityFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_szexport_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityFilter.ProtoReflect.Descriptor instead.
+func (*EntityFilter) Descriptor() ([]byte, []int) {
+	return file_szexport_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EntityFilter) GetDataSources() []string {
+	if x != nil {
+		return x.DataSources
+	}
+	return nil
+}
+
+func (x *EntityFilter) GetMinRecordCount() int64 {
+	if x != nil {
+		return x.MinRecordCount
+	}
+	return 0
+}
+
+func (x *EntityFilter) GetMinEntityId() int64 {
+	if x != nil {
+		return x.MinEntityId
+	}
+	return 0
+}
+
+func (x *EntityFilter) GetMaxEntityId() int64 {
+	if x != nil {
+		return x.MaxEntityId
+	}
+	return 0
+}
+
+type StreamEntityReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sequence      int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"` // Position of the row in the export, starting at 0.  For CSV, row 0 is the header.
+	Result        string                 `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // Set every checkpoint_interval rows.  Resumes after this row until it expires.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEntityReportResponse) Reset() {
+	*x = StreamEntityReportResponse{}
+	mi := &file_szexport_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEntityReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEntityReportResponse) ProtoMessage() {}
+
+func (x *StreamEntityReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szexport_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEntityReportResponse.ProtoReflect.Descriptor instead.
+func (*StreamEntityReportResponse) Descriptor() ([]byte, []int) {
+	return file_szexport_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StreamEntityReportResponse) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *StreamEntityReportResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *StreamEntityReportResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+var File_szexport_proto protoreflect.FileDescriptor
+
+const file_szexport_proto_rawDesc = "" +
+	"\n" +
+	"\x0eszexport.proto\x12\bszexport\"\xb0\x01\n" +
+	"\x1cStreamCsvEntityReportRequest\x12&\n" +
+	"\x0fcsv_column_list\x18\x01 \x01(\tR\rcsvColumnList\x12\x14\n" +
+	"\x05flags\x18\x02 \x01(\x03R\x05flags\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\x12/\n" +
+	"\x13checkpoint_interval\x18\x04 \x01(\x03R\x12checkpointInterval\"\xd1\x01\n" +
+	"\x1dStreamJsonEntityReportRequest\x12\x14\n" +
+	"\x05flags\x18\x01 \x01(\x03R\x05flags\x12!\n" +
+	"\fresume_token\x18\x02 \x01(\tR\vresumeToken\x12/\n" +
+	"\x13checkpoint_interval\x18\x03 \x01(\x03R\x12checkpointInterval\x12.\n" +
+	"\x06filter\x18\x04 \x01(\v2\x16.szexport.EntityFilterR\x06filter\x12\x16\n" +
+	"\x06fields\x18\x05 \x03(\tR\x06fields\"\xa3\x01\n" +
+	"\fEntityFilter\x12!\n" +
+	"\fdata_sources\x18\x01 \x03(\tR\vdataSources\x12(\n" +
+	"\x10min_record_count\x18\x02 \x01(\x03R\x0eminRecordCount\x12\"\n" +
+	"\rmin_entity_id\x18\x03 \x01(\x03R\vminEntityId\x12\"\n" +
+	"\rmax_entity_id\x18\x04 \x01(\x03R\vmaxEntityId\"s\n" +
+	"\x1aStreamEntityReportResponse\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12\x16\n" +
+	"\x06result\x18\x02 \x01(\tR\x06result\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken2\xe2\x01\n" +
+	"\bSzExport\x12i\n" +
+	"\x15StreamCsvEntityReport\x12&.szexport.StreamCsvEntityReportRequest\x1a$.szexport.StreamEntityReportResponse\"\x000\x01\x12k\n" +
+	"\x16StreamJsonEntityReport\x12'.szexport.StreamJsonEntityReportRequest\x1a$.szexport.StreamEntityReportResponse\"\x000\x01B8Z6github.com/senzing-garage/serve-grpc/proto/go/szexportb\x06proto3"
+
+var (
+	file_szexport_proto_rawDescOnce sync.Once
+	file_szexport_proto_rawDescData []byte
+)
+
+func file_szexport_proto_rawDescGZIP() []byte {
+	file_szexport_proto_rawDescOnce.Do(func() {
+		file_szexport_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_szexport_proto_rawDesc), len(file_szexport_proto_rawDesc)))
+	})
+	return file_szexport_proto_rawDescData
+}
+
+var file_szexport_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_szexport_proto_goTypes = []any{
+	(*StreamCsvEntityReportRequest)(nil),  // 0: szexport.StreamCsvEntityReportRequest
+	(*StreamJsonEntityReportRequest)(nil), // 1: szexport.StreamJsonEntityReportRequest
+	(*EntityFilter)(nil),                  // 2: szexport.EntityFilter
+	(*StreamEntityReportResponse)(nil),    // 3: szexport.StreamEntityReportResponse
+}
+var file_szexport_proto_depIdxs = []int32{
+	2, // 0: szexport.StreamJsonEntityReportRequest.filter:type_name -> szexport.EntityFilter
+	0, // 1: szexport.SzExport.StreamCsvEntityReport:input_type -> szexport.StreamCsvEntityReportRequest
+	1, // 2: szexport.SzExport.StreamJsonEntityReport:input_type -> szexport.StreamJsonEntityReportRequest
+	3, // 3: szexport.SzExport.StreamCsvEntityReport:output_type -> szexport.StreamEntityReportResponse
+	3, // 4: szexport.SzExport.StreamJsonEntityReport:output_type -> szexport.StreamEntityReportResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_szexport_proto_init() }
+func file_szexport_proto_init() {
+	if File_szexport_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_szexport_proto_rawDesc), len(file_szexport_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_szexport_proto_goTypes,
+		DependencyIndexes: file_szexport_proto_depIdxs,
+		MessageInfos:      file_szexport_proto_msgTypes,
+	}.Build()
+	File_szexport_proto = out.File
+	file_szexport_proto_goTypes = nil
+	file_szexport_proto_depIdxs = nil
+}
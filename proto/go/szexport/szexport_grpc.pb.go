@@ -0,0 +1,179 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: szexport.proto
+
+package szexport
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SzExport_StreamCsvEntityReport_FullMethodName  = "/szexport.SzExport/StreamCsvEntityReport"
+	SzExport_StreamJsonEntityReport_FullMethodName = "/szexport.SzExport/StreamJsonEntityReport"
+)
+
+// SzExportClient is the client API for SzExport service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SzExport streams entity reports that a client can resume after losing its connection.
+// Each row carries its sequence number, and every checkpoint_interval rows also carries a resume token.
+// Sending the token back in a new request restarts the export after that row.
+// The export is run again and the rows up to the token are skipped, so rows may shift if the repository changed in between.
+// JSON reports can be filtered and projected on the server; rows the filter drops are not sent but keep their sequence numbers.
+// A filtered report carries a resume token every checkpoint_interval rows sent, rather than every checkpoint_interval rows of the export.
+type SzExportClient interface {
+	StreamCsvEntityReport(ctx context.Context, in *StreamCsvEntityReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamEntityReportResponse], error)
+	StreamJsonEntityReport(ctx context.Context, in *StreamJsonEntityReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamEntityReportResponse], error)
+}
+
+type szExportClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSzExportClient(cc grpc.ClientConnInterface) SzExportClient {
+	return &szExportClient{cc}
+}
+
+func (c *szExportClient) StreamCsvEntityReport(ctx context.Context, in *StreamCsvEntityReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamEntityReportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SzExport_ServiceDesc.Streams[0], SzExport_StreamCsvEntityReport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamCsvEntityReportRequest, StreamEntityReportResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SzExport_StreamCsvEntityReportClient = grpc.ServerStreamingClient[StreamEntityReportResponse]
+
+func (c *szExportClient) StreamJsonEntityReport(ctx context.Context, in *StreamJsonEntityReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamEntityReportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SzExport_ServiceDesc.Streams[1], SzExport_StreamJsonEntityReport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamJsonEntityReportRequest, StreamEntityReportResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SzExport_StreamJsonEntityReportClient = grpc.ServerStreamingClient[StreamEntityReportResponse]
+
+// SzExportServer is the server API for SzExport service.
+// All implementations must embed UnimplementedSzExportServer
+// for forward compatibility.
+//
+// SzExport streams entity reports that a client can resume after losing its connection.
+// Each row carries its sequence number, and every checkpoint_interval rows also carries a resume token.
+// Sending the token back in a new request restarts the export after that row.
+// The export is run again and the rows up to the token are skipped, so rows may shift if the repository changed in between.
+// JSON reports can be filtered and projected on the server; rows the filter drops are not sent but keep their sequence numbers.
+// A filtered report carries a resume token every checkpoint_interval rows sent, rather than every checkpoint_interval rows of the export.
+type SzExportServer interface {
+	StreamCsvEntityReport(*StreamCsvEntityReportRequest, grpc.ServerStreamingServer[StreamEntityReportResponse]) error
+	StreamJsonEntityReport(*StreamJsonEntityReportRequest, grpc.ServerStreamingServer[StreamEntityReportResponse]) error
+	mustEmbedUnimplementedSzExportServer()
+}
+
+// UnimplementedSzExportServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSzExportServer struct{}
+
+func (UnimplementedSzExportServer) StreamCsvEntityReport(*StreamCsvEntityReportRequest, grpc.ServerStreamingServer[StreamEntityReportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamCsvEntityReport not implemented")
+}
+func (UnimplementedSzExportServer) StreamJsonEntityReport(*StreamJsonEntityReportRequest, grpc.ServerStreamingServer[StreamEntityReportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamJsonEntityReport not implemented")
+}
+func (UnimplementedSzExportServer) mustEmbedUnimplementedSzExportServer() {}
+func (UnimplementedSzExportServer) testEmbeddedByValue()                  {}
+
+// UnsafeSzExportServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SzExportServer will
+// result in compilation errors.
+type UnsafeSzExportServer interface {
+	mustEmbedUnimplementedSzExportServer()
+}
+
+func RegisterSzExportServer(s grpc.ServiceRegistrar, srv SzExportServer) {
+	// If the following call pancis, it indicates UnimplementedSzExportServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SzExport_ServiceDesc, srv)
+}
+
+func _SzExport_StreamCsvEntityReport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamCsvEntityReportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SzExportServer).StreamCsvEntityReport(m, &grpc.GenericServerStream[StreamCsvEntityReportRequest, StreamEntityReportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SzExport_StreamCsvEntityReportServer = grpc.ServerStreamingServer[StreamEntityReportResponse]
+
+func _SzExport_StreamJsonEntityReport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamJsonEntityReportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SzExportServer).StreamJsonEntityReport(m, &grpc.GenericServerStream[StreamJsonEntityReportRequest, StreamEntityReportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SzExport_StreamJsonEntityReportServer = grpc.ServerStreamingServer[StreamEntityReportResponse]
+
+// SzExport_ServiceDesc is the grpc.ServiceDesc for SzExport service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SzExport_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "szexport.SzExport",
+	HandlerType: (*SzExportServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamCsvEntityReport",
+			Handler:       _SzExport_StreamCsvEntityReport_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamJsonEntityReport",
+			Handler:       _SzExport_StreamJsonEntityReport_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "szexport.proto",
+}
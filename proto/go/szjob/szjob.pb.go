@@ -0,0 +1,821 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: szjob.proto
+
+package szjob
+
+import (
+	szadmin "github.com/senzing-garage/serve-grpc/proto/go/szadmin"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type JobState int32
+
+const (
+	JobState_JOB_STATE_UNSPECIFIED JobState = 0
+	JobState_JOB_STATE_PENDING     JobState = 1
+	JobState_JOB_STATE_RUNNING     JobState = 2
+	JobState_JOB_STATE_SUCCEEDED   JobState = 3
+	JobState_JOB_STATE_FAILED      JobState = 4
+	JobState_JOB_STATE_CANCELED    JobState = 5
+)
+
+// Enum value maps for JobState.
+var (
+	JobState_name = map[int32]string{
+		0: "JOB_STATE_UNSPECIFIED",
+		1: "JOB_STATE_PENDING",
+		2: "JOB_STATE_RUNNING",
+		3: "JOB_STATE_SUCCEEDED",
+		4: "JOB_STATE_FAILED",
+		5: "JOB_STATE_CANCELED",
+	}
+	JobState_value = map[string]int32{
+		"JOB_STATE_UNSPECIFIED": 0,
+		"JOB_STATE_PENDING":     1,
+		"JOB_STATE_RUNNING":     2,
+		"JOB_STATE_SUCCEEDED":   3,
+		"JOB_STATE_FAILED":      4,
+		"JOB_STATE_CANCELED":    5,
+	}
+)
+
+func (x JobState) Enum() *JobState {
+	p := new(JobState)
+	*p = x
+	return p
+}
+
+func (x JobState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobState) Descriptor() protoreflect.EnumDescriptor {
+	return file_szjob_proto_enumTypes[0].Descriptor()
+}
+
+func (JobState) Type() protoreflect.EnumType {
+	return &file_szjob_proto_enumTypes[0]
+}
+
+func (x JobState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobState.Descriptor instead.
+func (JobState) EnumDescriptor() ([]byte, []int) {
+	return file_szjob_proto_rawDescGZIP(), []int{0}
+}
+
+type SubmitJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Operation:
+	//
+	//	*SubmitJobRequest_CheckRepositoryPerformance
+	//	*SubmitJobRequest_PurgeRepository
+	//	*SubmitJobRequest_PrimeEngine
+	//	*SubmitJobRequest_ExportFile
+	Operation     isSubmitJobRequest_Operation `protobuf_oneof:"operation"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitJobRequest) Reset() {
+	*x = SubmitJobRequest{}
+	mi := &file_szjob_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitJobRequest) ProtoMessage() {}
+
+func (x *SubmitJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szjob_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitJobRequest.ProtoReflect.Descriptor instead.
+func (*SubmitJobRequest) Descriptor() ([]byte, []int) {
+	return file_szjob_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubmitJobRequest) GetOperation() isSubmitJobRequest_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetCheckRepositoryPerformance() *CheckRepositoryPerformance {
+	if x != nil {
+		if x, ok := x.Operation.(*SubmitJobRequest_CheckRepositoryPerformance); ok {
+			return x.CheckRepositoryPerformance
+		}
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetPurgeRepository() *PurgeRepository {
+	if x != nil {
+		if x, ok := x.Operation.(*SubmitJobRequest_PurgeRepository); ok {
+			return x.PurgeRepository
+		}
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetPrimeEngine() *PrimeEngine {
+	if x != nil {
+		if x, ok := x.Operation.(*SubmitJobRequest_PrimeEngine); ok {
+			return x.PrimeEngine
+		}
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetExportFile() *szadmin.StartFileExportRequest {
+	if x != nil {
+		if x, ok := x.Operation.(*SubmitJobRequest_ExportFile); ok {
+			return x.ExportFile
+		}
+	}
+	return nil
+}
+
+type isSubmitJobRequest_Operation interface {
+	isSubmitJobRequest_Operation()
+}
+
+type SubmitJobRequest_CheckRepositoryPerformance struct {
+	CheckRepositoryPerformance *CheckRepositoryPerformance `protobuf:"bytes,1,opt,name=check_repository_performance,json=checkRepositoryPerformance,proto3,oneof"`
+}
+
+type SubmitJobRequest_PurgeRepository struct {
+	PurgeRepository *PurgeRepository `protobuf:"bytes,2,opt,name=purge_repository,json=purgeRepository,proto3,oneof"`
+}
+
+type SubmitJobRequest_PrimeEngine struct {
+	PrimeEngine *PrimeEngine `protobuf:"bytes,3,opt,name=prime_engine,json=primeEngine,proto3,oneof"`
+}
+
+type SubmitJobRequest_ExportFile struct {
+	ExportFile *szadmin.StartFileExportRequest `protobuf:"bytes,4,opt,name=export_file,json=exportFile,proto3,oneof"`
+}
+
+func (*SubmitJobRequest_CheckRepositoryPerformance) isSubmitJobRequest_Operation() {}
+
+func (*SubmitJobRequest_PurgeRepository) isSubmitJobRequest_Operation() {}
+
+func (*SubmitJobRequest_PrimeEngine) isSubmitJobRequest_Operation() {}
+
+func (*SubmitJobRequest_ExportFile) isSubmitJobRequest_Operation() {}
+
+// SzDiagnostic.CheckRepositoryPerformance.  Needs the SzDiagnostic service.
+type CheckRepositoryPerformance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecondsToRun  int32                  `protobuf:"varint,1,opt,name=seconds_to_run,json=secondsToRun,proto3" json:"seconds_to_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckRepositoryPerformance) Reset() {
+	*x = CheckRepositoryPerformance{}
+	mi := &file_szjob_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckRepositoryPerformance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRepositoryPerformance) ProtoMessage() {}
+
+func (x *CheckRepositoryPerformance) ProtoReflect() protoreflect.Message {
+	mi := &file_szjob_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRepositoryPerformance.ProtoReflect.Descriptor instead.
+func (*CheckRepositoryPerformance) Descriptor() ([]byte, []int) {
+	return file_szjob_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CheckRepositoryPerformance) GetSecondsToRun() int32 {
+	if x != nil {
+		return x.SecondsToRun
+	}
+	return 0
+}
+
+// SzDiagnostic.PurgeRepository.  Needs the SzDiagnostic service and is refused by read-only servers.
+type PurgeRepository struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeRepository) Reset() {
+	*x = PurgeRepository{}
+	mi := &file_szjob_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeRepository) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeRepository) ProtoMessage() {}
+
+func (x *PurgeRepository) ProtoReflect() protoreflect.Message {
+	mi := &file_szjob_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeRepository.ProtoReflect.Descriptor instead.
+func (*PurgeRepository) Descriptor() ([]byte, []int) {
+	return file_szjob_proto_rawDescGZIP(), []int{2}
+}
+
+// SzEngine.PrimeEngine.  Needs the SzEngine service.
+type PrimeEngine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrimeEngine) Reset() {
+	*x = PrimeEngine{}
+	mi := &file_szjob_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrimeEngine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrimeEngine) ProtoMessage() {}
+
+func (x *PrimeEngine) ProtoReflect() protoreflect.Message {
+	mi := &file_szjob_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrimeEngine.ProtoReflect.Descriptor instead.
+func (*PrimeEngine) Descriptor() ([]byte, []int) {
+	return file_szjob_proto_rawDescGZIP(), []int{3}
+}
+
+type Job struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // Name of the operation, e.g. "purgeRepository".
+	State         JobState               `protobuf:"varint,3,opt,name=state,proto3,enum=szjob.JobState" json:"state,omitempty"`
+	Owner         string                 `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"` // Principal that submitted the job; empty without authentication.
+	Submitted     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=submitted,proto3" json:"submitted,omitempty"`
+	Started       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started,proto3" json:"started,omitempty"`                                                                              // Unset while pending.
+	Finished      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=finished,proto3" json:"finished,omitempty"`                                                                            // Unset until the job ends.
+	Progress      map[string]int64       `protobuf:"bytes,8,rep,name=progress,proto3" json:"progress,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Counters of the operation, e.g. "rows_written".
+	Result        string                 `protobuf:"bytes,9,opt,name=result,proto3" json:"result,omitempty"`                                                                                // JSON result of the operation, if it has one.
+	Error         string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode     int32                  `protobuf:"varint,11,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"` // gRPC status code of the error.
+	Request       *SubmitJobRequest      `protobuf:"bytes,12,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_szjob_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_szjob_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_szjob_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Job) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *Job) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Job) GetState() JobState {
+	if x != nil {
+		return x.State
+	}
+	return JobState_JOB_STATE_UNSPECIFIED
+}
+
+func (x *Job) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Job) GetSubmitted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Submitted
+	}
+	return nil
+}
+
+func (x *Job) GetStarted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *Job) GetFinished() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Finished
+	}
+	return nil
+}
+
+func (x *Job) GetProgress() map[string]int64 {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *Job) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *Job) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Job) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *Job) GetRequest() *SubmitJobRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type GetJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	mi := &file_szjob_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRequest) ProtoMessage() {}
+
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szjob_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_szjob_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type ListJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         JobState               `protobuf:"varint,1,opt,name=state,proto3,enum=szjob.JobState" json:"state,omitempty"` // Unset to list jobs in every state.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	mi := &file_szjob_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szjob_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_szjob_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListJobsRequest) GetState() JobState {
+	if x != nil {
+		return x.State
+	}
+	return JobState_JOB_STATE_UNSPECIFIED
+}
+
+type ListJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*Job                 `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	mi := &file_szjob_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szjob_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_szjob_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListJobsResponse) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type CancelJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelJobRequest) Reset() {
+	*x = CancelJobRequest{}
+	mi := &file_szjob_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobRequest) ProtoMessage() {}
+
+func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szjob_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelJobRequest) Descriptor() ([]byte, []int) {
+	return file_szjob_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type WatchJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchJobRequest) Reset() {
+	*x = WatchJobRequest{}
+	mi := &file_szjob_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchJobRequest) ProtoMessage() {}
+
+func (x *WatchJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szjob_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchJobRequest.ProtoReflect.Descriptor instead.
+func (*WatchJobRequest) Descriptor() ([]byte, []int) {
+	return file_szjob_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WatchJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+var File_szjob_proto protoreflect.FileDescriptor
+
+const file_szjob_proto_rawDesc = "" +
+	"\n" +
+	"\vszjob.proto\x12\x05szjob\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\rszadmin.proto\"\xc8\x02\n" +
+	"\x10SubmitJobRequest\x12e\n" +
+	"\x1ccheck_repository_performance\x18\x01 \x01(\v2!.szjob.CheckRepositoryPerformanceH\x00R\x1acheckRepositoryPerformance\x12C\n" +
+	"\x10purge_repository\x18\x02 \x01(\v2\x16.szjob.PurgeRepositoryH\x00R\x0fpurgeRepository\x127\n" +
+	"\fprime_engine\x18\x03 \x01(\v2\x12.szjob.PrimeEngineH\x00R\vprimeEngine\x12B\n" +
+	"\vexport_file\x18\x04 \x01(\v2\x1f.szadmin.StartFileExportRequestH\x00R\n" +
+	"exportFileB\v\n" +
+	"\toperation\"B\n" +
+	"\x1aCheckRepositoryPerformance\x12$\n" +
+	"\x0eseconds_to_run\x18\x01 \x01(\x05R\fsecondsToRun\"\x11\n" +
+	"\x0fPurgeRepository\"\r\n" +
+	"\vPrimeEngine\"\x88\x04\n" +
+	"\x03Job\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12%\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x0f.szjob.JobStateR\x05state\x12\x14\n" +
+	"\x05owner\x18\x04 \x01(\tR\x05owner\x128\n" +
+	"\tsubmitted\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tsubmitted\x124\n" +
+	"\astarted\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\astarted\x126\n" +
+	"\bfinished\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bfinished\x124\n" +
+	"\bprogress\x18\b \x03(\v2\x18.szjob.Job.ProgressEntryR\bprogress\x12\x16\n" +
+	"\x06result\x18\t \x01(\tR\x06result\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\v \x01(\x05R\terrorCode\x121\n" +
+	"\arequest\x18\f \x01(\v2\x17.szjob.SubmitJobRequestR\arequest\x1a;\n" +
+	"\rProgressEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"&\n" +
+	"\rGetJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"8\n" +
+	"\x0fListJobsRequest\x12%\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x0f.szjob.JobStateR\x05state\"2\n" +
+	"\x10ListJobsResponse\x12\x1e\n" +
+	"\x04jobs\x18\x01 \x03(\v2\n" +
+	".szjob.JobR\x04jobs\")\n" +
+	"\x10CancelJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"(\n" +
+	"\x0fWatchJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId*\x9a\x01\n" +
+	"\bJobState\x12\x19\n" +
+	"\x15JOB_STATE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11JOB_STATE_PENDING\x10\x01\x12\x15\n" +
+	"\x11JOB_STATE_RUNNING\x10\x02\x12\x17\n" +
+	"\x13JOB_STATE_SUCCEEDED\x10\x03\x12\x14\n" +
+	"\x10JOB_STATE_FAILED\x10\x04\x12\x16\n" +
+	"\x12JOB_STATE_CANCELED\x10\x052\x90\x02\n" +
+	"\x05SzJob\x122\n" +
+	"\tSubmitJob\x12\x17.szjob.SubmitJobRequest\x1a\n" +
+	".szjob.Job\"\x00\x12,\n" +
+	"\x06GetJob\x12\x14.szjob.GetJobRequest\x1a\n" +
+	".szjob.Job\"\x00\x12=\n" +
+	"\bListJobs\x12\x16.szjob.ListJobsRequest\x1a\x17.szjob.ListJobsResponse\"\x00\x122\n" +
+	"\tCancelJob\x12\x17.szjob.CancelJobRequest\x1a\n" +
+	".szjob.Job\"\x00\x122\n" +
+	"\bWatchJob\x12\x16.szjob.WatchJobRequest\x1a\n" +
+	".szjob.Job\"\x000\x01B5Z3github.com/senzing-garage/serve-grpc/proto/go/szjobb\x06proto3"
+
+var (
+	file_szjob_proto_rawDescOnce sync.Once
+	file_szjob_proto_rawDescData []byte
+)
+
+func file_szjob_proto_rawDescGZIP() []byte {
+	file_szjob_proto_rawDescOnce.Do(func() {
+		file_szjob_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_szjob_proto_rawDesc), len(file_szjob_proto_rawDesc)))
+	})
+	return file_szjob_proto_rawDescData
+}
+
+var file_szjob_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_szjob_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_szjob_proto_goTypes = []any{
+	(JobState)(0),                          // 0: szjob.JobState
+	(*SubmitJobRequest)(nil),               // 1: szjob.SubmitJobRequest
+	(*CheckRepositoryPerformance)(nil),     // 2: szjob.CheckRepositoryPerformance
+	(*PurgeRepository)(nil),                // 3: szjob.PurgeRepository
+	(*PrimeEngine)(nil),                    // 4: szjob.PrimeEngine
+	(*Job)(nil),                            // 5: szjob.Job
+	(*GetJobRequest)(nil),                  // 6: szjob.GetJobRequest
+	(*ListJobsRequest)(nil),                // 7: szjob.ListJobsRequest
+	(*ListJobsResponse)(nil),               // 8: szjob.ListJobsResponse
+	(*CancelJobRequest)(nil),               // 9: szjob.CancelJobRequest
+	(*WatchJobRequest)(nil),                // 10: szjob.WatchJobRequest
+	nil,                                    // 11: szjob.Job.ProgressEntry
+	(*szadmin.StartFileExportRequest)(nil), // 12: szadmin.StartFileExportRequest
+	(*timestamppb.Timestamp)(nil),          // 13: google.protobuf.Timestamp
+}
+var file_szjob_proto_depIdxs = []int32{
+	2,  // 0: szjob.SubmitJobRequest.check_repository_performance:type_name -> szjob.CheckRepositoryPerformance
+	3,  // 1: szjob.SubmitJobRequest.purge_repository:type_name -> szjob.PurgeRepository
+	4,  // 2: szjob.SubmitJobRequest.prime_engine:type_name -> szjob.PrimeEngine
+	12, // 3: szjob.SubmitJobRequest.export_file:type_name -> szadmin.StartFileExportRequest
+	0,  // 4: szjob.Job.state:type_name -> szjob.JobState
+	13, // 5: szjob.Job.submitted:type_name -> google.protobuf.Timestamp
+	13, // 6: szjob.Job.started:type_name -> google.protobuf.Timestamp
+	13, // 7: szjob.Job.finished:type_name -> google.protobuf.Timestamp
+	11, // 8: szjob.Job.progress:type_name -> szjob.Job.ProgressEntry
+	1,  // 9: szjob.Job.request:type_name -> szjob.SubmitJobRequest
+	0,  // 10: szjob.ListJobsRequest.state:type_name -> szjob.JobState
+	5,  // 11: szjob.ListJobsResponse.jobs:type_name -> szjob.Job
+	1,  // 12: szjob.SzJob.SubmitJob:input_type -> szjob.SubmitJobRequest
+	6,  // 13: szjob.SzJob.GetJob:input_type -> szjob.GetJobRequest
+	7,  // 14: szjob.SzJob.ListJobs:input_type -> szjob.ListJobsRequest
+	9,  // 15: szjob.SzJob.CancelJob:input_type -> szjob.CancelJobRequest
+	10, // 16: szjob.SzJob.WatchJob:input_type -> szjob.WatchJobRequest
+	5,  // 17: szjob.SzJob.SubmitJob:output_type -> szjob.Job
+	5,  // 18: szjob.SzJob.GetJob:output_type -> szjob.Job
+	8,  // 19: szjob.SzJob.ListJobs:output_type -> szjob.ListJobsResponse
+	5,  // 20: szjob.SzJob.CancelJob:output_type -> szjob.Job
+	5,  // 21: szjob.SzJob.WatchJob:output_type -> szjob.Job
+	17, // [17:22] is the sub-list for method output_type
+	12, // [12:17] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_szjob_proto_init() }
+func file_szjob_proto_init() {
+	if File_szjob_proto != nil {
+		return
+	}
+	file_szjob_proto_msgTypes[0].OneofWrappers = []any{
+		(*SubmitJobRequest_CheckRepositoryPerformance)(nil),
+		(*SubmitJobRequest_PurgeRepository)(nil),
+		(*SubmitJobRequest_PrimeEngine)(nil),
+		(*SubmitJobRequest_ExportFile)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_szjob_proto_rawDesc), len(file_szjob_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_szjob_proto_goTypes,
+		DependencyIndexes: file_szjob_proto_depIdxs,
+		EnumInfos:         file_szjob_proto_enumTypes,
+		MessageInfos:      file_szjob_proto_msgTypes,
+	}.Build()
+	File_szjob_proto = out.File
+	file_szjob_proto_goTypes = nil
+	file_szjob_proto_depIdxs = nil
+}
@@ -0,0 +1,4 @@
+/*
+Package szbatchserver handles gRPC requests that make many SzEngine reads in one call.
+*/
+package szbatchserver
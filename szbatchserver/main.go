@@ -0,0 +1,59 @@
+package szbatchserver
+
+import (
+	"errors"
+
+	"github.com/senzing-garage/go-logging/logging"
+	szpb "github.com/senzing-garage/serve-grpc/proto/go/szbatch"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// SzBatchServer implements the szbatch.SzBatch service using the szengineserver SzEngine singleton.
+type SzBatchServer struct {
+	szpb.UnimplementedSzBatchServer
+	isTrace         bool
+	logger          logging.Logging
+	MaxItems        int               // Items allowed in one request; 0 means DefaultMaxItems.
+	StatusFromError func(error) error // Translates a Senzing error into a gRPC status error for BatchResult.
+	Workers         int               // Items read at the same time by one call; 0 means DefaultWorkers.
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Identfier of the szbatchserver package found messages having the format "senzing-6999xxxx".
+const ComponentID = 6019
+
+// Log message prefix.
+const Prefix = "serve-grpc.szbatchserver."
+
+// Items allowed in one request when MaxItems is not set.
+const DefaultMaxItems = 1000
+
+// Items read at the same time by one call when Workers is not set.
+const DefaultWorkers = 8
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Message templates for the szbatchserver package.
+var IDMessages = map[int]string{
+	1: "Enter " + Prefix + "GetEntities(%+v).",
+	2: "Exit  " + Prefix + "GetEntities(%+v) returned (%d results, %v).",
+	3: "Enter " + Prefix + "GetRecords(%+v).",
+	4: "Exit  " + Prefix + "GetRecords(%+v) returned (%d results, %v).",
+	5: "Enter " + Prefix + "SetLogLevel(%s).",
+	6: "Exit  " + Prefix + "SetLogLevel(%s) returned (%v).",
+	7: "Enter " + Prefix + "SearchByAttributes(%+v).",
+	8: "Exit  " + Prefix + "SearchByAttributes(%+v) returned (%d results, %v).",
+}
+
+// Status strings for specific szbatchserver messages.
+var IDStatuses = map[int]string{}
+
+var errPackage = errors.New("szbatchserver")
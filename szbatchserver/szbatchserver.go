@@ -0,0 +1,272 @@
+package szbatchserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/go-logging/logging"
+	szpb "github.com/senzing-garage/serve-grpc/proto/go/szbatch"
+	"github.com/senzing-garage/serve-grpc/szengineserver"
+	"github.com/senzing-garage/serve-grpc/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const OptionCallerSkip = 3
+
+// ----------------------------------------------------------------------------
+// Interface methods for github.com/senzing-garage/serve-grpc/proto/go/szbatch.SzBatchServer
+// ----------------------------------------------------------------------------
+
+/*
+The GetEntities method gets each entity of request.EntityIds with the same flags and
+returns one BatchResult per entity id, in request order.
+*/
+func (server *SzBatchServer) GetEntities(
+	ctx context.Context,
+	request *szpb.GetEntitiesRequest,
+) (*szpb.BatchResponse, error) {
+	var (
+		err    error
+		result *szpb.BatchResponse
+	)
+
+	if server.isTrace {
+		entryTime := time.Now()
+
+		server.traceEntry(1, request)
+
+		defer func() { server.traceExit(2, request, len(result.GetResults()), err, time.Since(entryTime)) }()
+	}
+
+	ctx, span := tracing.StartSdkSpan(ctx, "SzBatch.GetEntities", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
+	result, err = batch(ctx, server, request.GetEntityIds(), func(ctx context.Context, entityID int64) (string, error) {
+		return szengineserver.GetSdkSzEngine().GetEntityByEntityID(ctx, entityID, request.GetFlags())
+	})
+
+	return result, err
+}
+
+/*
+The GetRecords method gets each record of request.RecordKeys with the same flags and
+returns one BatchResult per record key, in request order.
+*/
+func (server *SzBatchServer) GetRecords(
+	ctx context.Context,
+	request *szpb.GetRecordsRequest,
+) (*szpb.BatchResponse, error) {
+	var (
+		err    error
+		result *szpb.BatchResponse
+	)
+
+	if server.isTrace {
+		entryTime := time.Now()
+
+		server.traceEntry(3, request)
+
+		defer func() { server.traceExit(4, request, len(result.GetResults()), err, time.Since(entryTime)) }()
+	}
+
+	ctx, span := tracing.StartSdkSpan(ctx, "SzBatch.GetRecords", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
+	result, err = batch(ctx, server, request.GetRecordKeys(), func(ctx context.Context, recordKey *szpb.RecordKey) (string, error) {
+		return szengineserver.GetSdkSzEngine().GetRecord(
+			ctx,
+			recordKey.GetDataSourceCode(),
+			recordKey.GetRecordId(),
+			request.GetFlags(),
+		)
+	})
+
+	return result, err
+}
+
+/*
+The SearchByAttributes method runs one search per element of request.Attributes,
+with the same search profile and flags, and returns one BatchResult per search, in
+request order.
+*/
+func (server *SzBatchServer) SearchByAttributes(
+	ctx context.Context,
+	request *szpb.SearchByAttributesRequest,
+) (*szpb.BatchResponse, error) {
+	var (
+		err    error
+		result *szpb.BatchResponse
+	)
+
+	if server.isTrace {
+		entryTime := time.Now()
+
+		server.traceEntry(7, request)
+
+		defer func() { server.traceExit(8, request, len(result.GetResults()), err, time.Since(entryTime)) }()
+	}
+
+	ctx, span := tracing.StartSdkSpan(ctx, "SzBatch.SearchByAttributes", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
+	result, err = batch(ctx, server, request.GetAttributes(), func(ctx context.Context, attributes string) (string, error) {
+		return szengineserver.GetSdkSzEngine().SearchByAttributes(
+			ctx,
+			attributes,
+			request.GetSearchProfile(),
+			request.GetFlags(),
+		)
+	})
+
+	return result, err
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (server *SzBatchServer) getMaxItems() int {
+	if server.MaxItems > 0 {
+		return server.MaxItems
+	}
+
+	return DefaultMaxItems
+}
+
+func (server *SzBatchServer) getWorkers() int {
+	if server.Workers > 0 {
+		return server.Workers
+	}
+
+	return DefaultWorkers
+}
+
+// Build the result for one item, translating err as a unary call would.
+func (server *SzBatchServer) itemResult(result string, err error) *szpb.BatchResult {
+	if err == nil {
+		return &szpb.BatchResult{Result: result}
+	}
+
+	errStatus := status.New(codes.Unknown, err.Error())
+	if server.StatusFromError != nil {
+		errStatus = status.Convert(server.StatusFromError(err))
+	}
+
+	return &szpb.BatchResult{
+		ErrorCode: int32(errStatus.Code()), //nolint:gosec // gRPC codes are small.
+		Error:     errStatus.Message(),
+	}
+}
+
+// --- Logging ----------------------------------------------------------------
+
+// Get the Logger singleton.
+func (server *SzBatchServer) getLogger() logging.Logging {
+	var err error
+
+	if server.logger == nil {
+		options := []interface{}{
+			&logging.OptionCallerSkip{Value: OptionCallerSkip},
+		}
+
+		server.logger, err = logging.NewSenzingLogger(ComponentID, IDMessages, options...)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return server.logger
+}
+
+// Trace method entry.
+func (server *SzBatchServer) traceEntry(messageNumber int, details ...interface{}) {
+	server.getLogger().Log(messageNumber, details...)
+}
+
+// Trace method exit.
+func (server *SzBatchServer) traceExit(messageNumber int, details ...interface{}) {
+	server.getLogger().Log(messageNumber, details...)
+}
+
+func (server *SzBatchServer) SetLogLevel(ctx context.Context, logLevelName string) error {
+	_ = ctx
+
+	var err error
+
+	if server.isTrace {
+		entryTime := time.Now()
+
+		server.traceEntry(5, logLevelName)
+
+		defer func() { server.traceExit(6, logLevelName, err, time.Since(entryTime)) }()
+	}
+
+	if !logging.IsValidLogLevelName(logLevelName) {
+		return wraperror.Errorf(errPackage, "invalid error level: %s", logLevelName)
+	}
+
+	err = server.getLogger().SetLogLevel(logLevelName)
+	if err != nil {
+		return wraperror.Errorf(err, "SetLogLevel: %s", logLevelName)
+	}
+
+	server.isTrace = (logLevelName == logging.LevelTraceName)
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+/*
+Run read for each item on at most Workers goroutines and collect the results in the
+order of items.  A failing item is reported in its result; only too many items, or
+the end of ctx, fails the call.
+*/
+func batch[Item any](
+	ctx context.Context,
+	server *SzBatchServer,
+	items []Item,
+	read func(context.Context, Item) (string, error),
+) (*szpb.BatchResponse, error) {
+	if len(items) > server.getMaxItems() {
+		return nil, status.Errorf(codes.InvalidArgument, "%d items requested; at most %d are allowed", len(items), server.getMaxItems())
+	}
+
+	var (
+		nextIndex   int
+		mutex       sync.Mutex
+		workerGroup sync.WaitGroup
+	)
+
+	results := make([]*szpb.BatchResult, len(items))
+
+	for range min(server.getWorkers(), len(items)) {
+		workerGroup.Go(func() {
+			for ctx.Err() == nil {
+				mutex.Lock()
+				index := nextIndex
+				nextIndex++
+				mutex.Unlock()
+
+				if index >= len(items) {
+					return
+				}
+
+				results[index] = server.itemResult(read(ctx, items[index]))
+			}
+		})
+	}
+
+	workerGroup.Wait()
+
+	if ctx.Err() != nil {
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+
+	return &szpb.BatchResponse{Results: results}, nil
+}
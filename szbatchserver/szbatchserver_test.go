@@ -0,0 +1,26 @@
+package szbatchserver_test
+
+import (
+	"testing"
+
+	"github.com/senzing-garage/serve-grpc/szbatchserver"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSzBatch_SetLogLevel(test *testing.T) {
+	ctx := test.Context()
+	testObject := &szbatchserver.SzBatchServer{}
+	err := testObject.SetLogLevel(ctx, "DEBUG")
+	require.NoError(test, err)
+}
+
+func TestSzBatch_SetLogLevel_badLevelName(test *testing.T) {
+	ctx := test.Context()
+	testObject := &szbatchserver.SzBatchServer{}
+	err := testObject.SetLogLevel(ctx, "BADLEVELNAME")
+	require.Error(test, err)
+}
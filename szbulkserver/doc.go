@@ -0,0 +1,4 @@
+/*
+Package szbulkserver handles streaming gRPC requests that make many SzEngine calls through one RPC.
+*/
+package szbulkserver
@@ -0,0 +1,63 @@
+package szbulkserver
+
+import (
+	"errors"
+
+	"github.com/senzing-garage/go-logging/logging"
+	szpb "github.com/senzing-garage/serve-grpc/proto/go/szbulk"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// SzBulkServer implements the szbulk.SzBulk service using the szengineserver SzEngine singleton.
+type SzBulkServer struct {
+	szpb.UnimplementedSzBulkServer
+	isTrace          bool
+	logger           logging.Logging
+	ProgressInterval int               // Items between BulkProgress messages; 0 means DefaultProgressInterval.
+	StatusFromError  func(error) error // Translates a Senzing error into a gRPC status error for BulkRecordResponse.
+	Workers          int               // Items processed at the same time by one stream; 0 means GOMAXPROCS.
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Identfier of the szbulkserver package found messages having the format "senzing-6999xxxx".
+const ComponentID = 6017
+
+// Log message prefix.
+const Prefix = "serve-grpc.szbulkserver."
+
+// Items between BulkProgress messages when ProgressInterval is not set.
+const DefaultProgressInterval = 1000
+
+// Maximum number of BulkSummaryResponse.Failures.
+const MaxSummaryFailures = 100
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Message templates for the szbulkserver package.
+var IDMessages = map[int]string{
+	1:  "Enter " + Prefix + "AddRecords().",
+	2:  "Exit  " + Prefix + "AddRecords() received %d records and returned (%v).",
+	3:  "Enter " + Prefix + "LoadRecords().",
+	4:  "Exit  " + Prefix + "LoadRecords() returned (%+v, %v).",
+	5:  "Enter " + Prefix + "SetLogLevel(%s).",
+	6:  "Exit  " + Prefix + "SetLogLevel(%s) returned (%v).",
+	7:  "Enter " + Prefix + "DeleteRecords().",
+	8:  "Exit  " + Prefix + "DeleteRecords() returned (%+v, %v).",
+	9:  "Enter " + Prefix + "ReevaluateEntities().",
+	10: "Exit  " + Prefix + "ReevaluateEntities() returned (%+v, %v).",
+	11: "Enter " + Prefix + "ReevaluateRecords().",
+	12: "Exit  " + Prefix + "ReevaluateRecords() returned (%+v, %v).",
+}
+
+// Status strings for specific szbulkserver messages.
+var IDStatuses = map[int]string{}
+
+var errPackage = errors.New("szbulkserver")
@@ -0,0 +1,547 @@
+package szbulkserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/go-logging/logging"
+	szpb "github.com/senzing-garage/serve-grpc/proto/go/szbulk"
+	"github.com/senzing-garage/serve-grpc/szengineserver"
+	"github.com/senzing-garage/serve-grpc/tracing"
+	szenginepb "github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A bulkTask is one request read from a client stream.
+type bulkTask[Request any] struct {
+	request  Request
+	sequence int64
+}
+
+// A progressTracker counts the responses of one stream for BulkProgress.
+type progressTracker struct {
+	affectedEntities map[int64]bool
+	progress         *szpb.BulkProgress
+}
+
+// The part of a WITH_INFO result that lists affected entities.
+type withInfo struct {
+	AffectedEntities []struct {
+		EntityID int64 `json:"ENTITY_ID"`
+	} `json:"AFFECTED_ENTITIES"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const OptionCallerSkip = 3
+
+// ----------------------------------------------------------------------------
+// Interface methods for github.com/senzing-garage/serve-grpc/proto/go/szbulk.SzBulkServer
+// ----------------------------------------------------------------------------
+
+/*
+The AddRecords method adds each record sent on the client stream and streams back
+one BulkRecordResponse per record, in completion order.
+
+Records are read only as fast as the workers add them and the client reads the
+results, so a fast client is slowed down by gRPC flow control instead of filling
+server memory.
+*/
+func (server *SzBulkServer) AddRecords(stream szpb.SzBulk_AddRecordsServer) error {
+	var (
+		err      error
+		received int64
+	)
+
+	if server.isTrace {
+		entryTime := time.Now()
+
+		server.traceEntry(1)
+
+		defer func() { server.traceExit(2, received, err, time.Since(entryTime)) }()
+	}
+
+	ctx, span := tracing.StartSdkSpan(stream.Context(), "SzBulk.AddRecords", nil)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
+	received, err = process(ctx, server.getWorkers(), stream.Recv, server.addRecord, stream.Send)
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+The DeleteRecords method deletes each record sent on the client stream, streaming
+back one result per record and a BulkProgress every ProgressInterval records and
+at the end of the stream.
+*/
+func (server *SzBulkServer) DeleteRecords(stream szpb.SzBulk_DeleteRecordsServer) error {
+	var (
+		err      error
+		progress *szpb.BulkProgress
+	)
+
+	if server.isTrace {
+		entryTime := time.Now()
+
+		server.traceEntry(7)
+
+		defer func() { server.traceExit(8, progress, err, time.Since(entryTime)) }()
+	}
+
+	ctx, span := tracing.StartSdkSpan(stream.Context(), "SzBulk.DeleteRecords", nil)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
+	progress, err = processWithProgress(ctx, server, stream.Recv, server.deleteRecord, stream.Send)
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+The LoadRecords method adds each record sent on the client stream and, once the
+client closes its stream, returns counts and the first MaxSummaryFailures failures.
+*/
+func (server *SzBulkServer) LoadRecords(stream szpb.SzBulk_LoadRecordsServer) error {
+	var err error
+
+	summary := &szpb.BulkSummaryResponse{}
+
+	if server.isTrace {
+		entryTime := time.Now()
+
+		server.traceEntry(3)
+
+		defer func() { server.traceExit(4, summary, err, time.Since(entryTime)) }()
+	}
+
+	ctx, span := tracing.StartSdkSpan(stream.Context(), "SzBulk.LoadRecords", nil)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
+	summary.Received, err = process(ctx, server.getWorkers(), stream.Recv, server.addRecord, summarize(summary))
+	if err != nil {
+		return wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	err = stream.SendAndClose(summary)
+
+	return wraperror.Errorf(err, "stream.SendAndClose")
+}
+
+/*
+The ReevaluateEntities method reevaluates each entity sent on the client stream,
+streaming back one result per entity and a BulkProgress every ProgressInterval
+entities and at the end of the stream.
+*/
+func (server *SzBulkServer) ReevaluateEntities(stream szpb.SzBulk_ReevaluateEntitiesServer) error {
+	var (
+		err      error
+		progress *szpb.BulkProgress
+	)
+
+	if server.isTrace {
+		entryTime := time.Now()
+
+		server.traceEntry(9)
+
+		defer func() { server.traceExit(10, progress, err, time.Since(entryTime)) }()
+	}
+
+	ctx, span := tracing.StartSdkSpan(stream.Context(), "SzBulk.ReevaluateEntities", nil)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
+	progress, err = processWithProgress(ctx, server, stream.Recv, server.reevaluateEntity, stream.Send)
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+/*
+The ReevaluateRecords method reevaluates each record sent on the client stream,
+streaming back one result per record and a BulkProgress every ProgressInterval
+records and at the end of the stream.
+*/
+func (server *SzBulkServer) ReevaluateRecords(stream szpb.SzBulk_ReevaluateRecordsServer) error {
+	var (
+		err      error
+		progress *szpb.BulkProgress
+	)
+
+	if server.isTrace {
+		entryTime := time.Now()
+
+		server.traceEntry(11)
+
+		defer func() { server.traceExit(12, progress, err, time.Since(entryTime)) }()
+	}
+
+	ctx, span := tracing.StartSdkSpan(stream.Context(), "SzBulk.ReevaluateRecords", nil)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
+	progress, err = processWithProgress(ctx, server, stream.Recv, server.reevaluateRecord, stream.Send)
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (server *SzBulkServer) addRecord(
+	ctx context.Context,
+	sequence int64,
+	request *szenginepb.AddRecordRequest,
+) *szpb.BulkRecordResponse {
+	result, err := szengineserver.GetSdkSzEngine().AddRecord(
+		ctx,
+		request.GetDataSourceCode(),
+		request.GetRecordId(),
+		request.GetRecordDefinition(),
+		request.GetFlags(),
+	)
+
+	return server.recordResponse(sequence, request.GetDataSourceCode(), request.GetRecordId(), result, err)
+}
+
+func (server *SzBulkServer) deleteRecord(
+	ctx context.Context,
+	sequence int64,
+	request *szenginepb.DeleteRecordRequest,
+) *szpb.BulkRecordResponse {
+	result, err := szengineserver.GetSdkSzEngine().DeleteRecord(
+		ctx,
+		request.GetDataSourceCode(),
+		request.GetRecordId(),
+		request.GetFlags(),
+	)
+
+	return server.recordResponse(sequence, request.GetDataSourceCode(), request.GetRecordId(), result, err)
+}
+
+func (server *SzBulkServer) getProgressInterval() int64 {
+	if server.ProgressInterval > 0 {
+		return int64(server.ProgressInterval)
+	}
+
+	return DefaultProgressInterval
+}
+
+func (server *SzBulkServer) getWorkers() int {
+	if server.Workers > 0 {
+		return server.Workers
+	}
+
+	return runtime.GOMAXPROCS(0)
+}
+
+func (server *SzBulkServer) reevaluateEntity(
+	ctx context.Context,
+	sequence int64,
+	request *szenginepb.ReevaluateEntityRequest,
+) *szpb.BulkRecordResponse {
+	result, err := szengineserver.GetSdkSzEngine().ReevaluateEntity(
+		ctx,
+		request.GetEntityId(),
+		request.GetFlags(),
+	)
+
+	response := server.recordResponse(sequence, "", "", result, err)
+	response.EntityId = request.GetEntityId()
+
+	return response
+}
+
+func (server *SzBulkServer) reevaluateRecord(
+	ctx context.Context,
+	sequence int64,
+	request *szenginepb.ReevaluateRecordRequest,
+) *szpb.BulkRecordResponse {
+	result, err := szengineserver.GetSdkSzEngine().ReevaluateRecord(
+		ctx,
+		request.GetDataSourceCode(),
+		request.GetRecordId(),
+		request.GetFlags(),
+	)
+
+	return server.recordResponse(sequence, request.GetDataSourceCode(), request.GetRecordId(), result, err)
+}
+
+// Build the response for one record, translating err as a unary call would.
+func (server *SzBulkServer) recordResponse(
+	sequence int64,
+	dataSourceCode string,
+	recordID string,
+	result string,
+	err error,
+) *szpb.BulkRecordResponse {
+	response := &szpb.BulkRecordResponse{
+		Sequence:       sequence,
+		DataSourceCode: dataSourceCode,
+		RecordId:       recordID,
+		Result:         result,
+	}
+
+	if err != nil {
+		errStatus := status.New(codes.Unknown, err.Error())
+		if server.StatusFromError != nil {
+			errStatus = status.Convert(server.StatusFromError(err))
+		}
+
+		response.ErrorCode = int32(errStatus.Code()) //nolint:gosec // gRPC codes are small.
+		response.Error = errStatus.Message()
+	}
+
+	return response
+}
+
+// Count response in the progress.
+func (tracker *progressTracker) add(response *szpb.BulkRecordResponse) {
+	tracker.progress.Completed++
+
+	switch codes.Code(response.GetErrorCode()) { //nolint:gosec // gRPC codes are small.
+	case codes.OK:
+		tracker.progress.Succeeded++
+	case codes.NotFound:
+		tracker.progress.NotFound++
+	default:
+		tracker.progress.Failed++
+	}
+
+	var info withInfo
+
+	if json.Unmarshal([]byte(response.GetResult()), &info) != nil {
+		return
+	}
+
+	for _, affectedEntity := range info.AffectedEntities {
+		tracker.affectedEntities[affectedEntity.EntityID] = true
+	}
+
+	tracker.progress.AffectedEntities = int64(len(tracker.affectedEntities))
+}
+
+// --- Logging ----------------------------------------------------------------
+
+// Get the Logger singleton.
+func (server *SzBulkServer) getLogger() logging.Logging {
+	var err error
+
+	if server.logger == nil {
+		options := []interface{}{
+			&logging.OptionCallerSkip{Value: OptionCallerSkip},
+		}
+
+		server.logger, err = logging.NewSenzingLogger(ComponentID, IDMessages, options...)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return server.logger
+}
+
+// Trace method entry.
+func (server *SzBulkServer) traceEntry(messageNumber int, details ...interface{}) {
+	server.getLogger().Log(messageNumber, details...)
+}
+
+// Trace method exit.
+func (server *SzBulkServer) traceExit(messageNumber int, details ...interface{}) {
+	server.getLogger().Log(messageNumber, details...)
+}
+
+func (server *SzBulkServer) SetLogLevel(ctx context.Context, logLevelName string) error {
+	_ = ctx
+
+	var err error
+
+	if server.isTrace {
+		entryTime := time.Now()
+
+		server.traceEntry(5, logLevelName)
+
+		defer func() { server.traceExit(6, logLevelName, err, time.Since(entryTime)) }()
+	}
+
+	if !logging.IsValidLogLevelName(logLevelName) {
+		return wraperror.Errorf(errPackage, "invalid error level: %s", logLevelName)
+	}
+
+	err = server.getLogger().SetLogLevel(logLevelName)
+	if err != nil {
+		return wraperror.Errorf(err, "SetLogLevel: %s", logLevelName)
+	}
+
+	server.isTrace = (logLevelName == logging.LevelTraceName)
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+/*
+Run each request from receive through handle on a pool of workers and pass each
+response to send, from a single goroutine.  The channels between the stages hold
+at most one item per worker, which is what applies backpressure to the client.
+
+A failing request is reported in its response.  Only a failure to receive or send,
+or the end of ctx, stops the stream.  The result is the number of requests received.
+*/
+func process[Request any](
+	ctx context.Context,
+	workers int,
+	receive func() (Request, error),
+	handle func(context.Context, int64, Request) *szpb.BulkRecordResponse,
+	send func(*szpb.BulkRecordResponse) error,
+) (int64, error) {
+	var (
+		received    int64
+		receiveErr  error
+		sendErr     error
+		workerGroup sync.WaitGroup
+	)
+
+	processCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	tasks := make(chan bulkTask[Request], workers)
+	responses := make(chan *szpb.BulkRecordResponse, workers)
+	receiverDone := make(chan struct{})
+
+	go func() {
+		defer close(receiverDone)
+		defer close(tasks)
+
+		for {
+			request, err := receive()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			if err != nil {
+				receiveErr = err
+
+				cancel()
+
+				return
+			}
+
+			select {
+			case tasks <- bulkTask[Request]{request: request, sequence: received}:
+				received++
+			case <-processCtx.Done():
+				return
+			}
+		}
+	}()
+
+	for range workers {
+		workerGroup.Go(func() {
+			for task := range tasks {
+				if processCtx.Err() != nil {
+					continue
+				}
+
+				select {
+				case responses <- handle(processCtx, task.sequence, task.request):
+				case <-processCtx.Done():
+				}
+			}
+		})
+	}
+
+	go func() {
+		workerGroup.Wait()
+		close(responses)
+	}()
+
+	for response := range responses {
+		if sendErr != nil {
+			continue
+		}
+
+		sendErr = send(response)
+		if sendErr != nil {
+			cancel()
+		}
+	}
+
+	<-receiverDone
+
+	switch {
+	case sendErr != nil:
+		return received, wraperror.Errorf(sendErr, "send")
+	case receiveErr != nil:
+		return received, wraperror.Errorf(receiveErr, "receive")
+	default:
+		return received, wraperror.Errorf(ctx.Err(), wraperror.NoMessage)
+	}
+}
+
+/*
+Like process, but wrap each response in a BulkProgressResponse and follow every
+ProgressInterval of them, and the last, with the counts so far.  Affected entities
+are counted only when the requests ask for WITH_INFO.
+*/
+func processWithProgress[Request any](
+	ctx context.Context,
+	server *SzBulkServer,
+	receive func() (Request, error),
+	handle func(context.Context, int64, Request) *szpb.BulkRecordResponse,
+	send func(*szpb.BulkProgressResponse) error,
+) (*szpb.BulkProgress, error) {
+	tracker := &progressTracker{
+		affectedEntities: map[int64]bool{},
+		progress:         &szpb.BulkProgress{},
+	}
+	interval := server.getProgressInterval()
+
+	_, err := process(ctx, server.getWorkers(), receive, handle, func(response *szpb.BulkRecordResponse) error {
+		tracker.add(response)
+
+		err := send(&szpb.BulkProgressResponse{Response: &szpb.BulkProgressResponse_Result{Result: response}})
+		if err != nil || tracker.progress.GetCompleted()%interval != 0 {
+			return err
+		}
+
+		return send(&szpb.BulkProgressResponse{Response: &szpb.BulkProgressResponse_Progress{Progress: tracker.progress}})
+	})
+	if err != nil {
+		return tracker.progress, wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	err = send(&szpb.BulkProgressResponse{Response: &szpb.BulkProgressResponse_Progress{Progress: tracker.progress}})
+
+	return tracker.progress, wraperror.Errorf(err, "send")
+}
+
+// Count each response in summary, keeping the first MaxSummaryFailures failures.
+func summarize(summary *szpb.BulkSummaryResponse) func(*szpb.BulkRecordResponse) error {
+	return func(response *szpb.BulkRecordResponse) error {
+		if response.GetErrorCode() == int32(codes.OK) {
+			summary.Succeeded++
+
+			return nil
+		}
+
+		summary.Failed++
+
+		if len(summary.GetFailures()) < MaxSummaryFailures {
+			summary.Failures = append(summary.Failures, response)
+		}
+
+		return nil
+	}
+}
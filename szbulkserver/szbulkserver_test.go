@@ -0,0 +1,26 @@
+package szbulkserver_test
+
+import (
+	"testing"
+
+	"github.com/senzing-garage/serve-grpc/szbulkserver"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSzBulk_SetLogLevel(test *testing.T) {
+	ctx := test.Context()
+	testObject := &szbulkserver.SzBulkServer{}
+	err := testObject.SetLogLevel(ctx, "DEBUG")
+	require.NoError(test, err)
+}
+
+func TestSzBulk_SetLogLevel_badLevelName(test *testing.T) {
+	ctx := test.Context()
+	testObject := &szbulkserver.SzBulkServer{}
+	err := testObject.SetLogLevel(ctx, "BADLEVELNAME")
+	require.Error(test, err)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/senzing-garage/go-helpers/wraperror"
 	"github.com/senzing-garage/go-logging/logging"
 	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/serve-grpc/tracing"
 	szsdk "github.com/senzing-garage/sz-sdk-go-core/szconfigmanager"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
 	szpb "github.com/senzing-garage/sz-sdk-proto/go/szconfigmanager"
@@ -42,6 +43,9 @@ func (server *SzConfigManagerServer) GetConfig(
 		defer func() { server.traceExit(8, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzConfigManager.GetConfig", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szConfigManager := getSzConfigManager()
 
 	szConfig, err := szConfigManager.CreateConfigFromConfigID(ctx, request.GetConfigId())
@@ -75,6 +79,9 @@ func (server *SzConfigManagerServer) GetConfigRegistry(
 		defer func() { server.traceExit(10, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzConfigManager.GetConfigRegistry", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szConfigManager := getSzConfigManager()
 	result, err = szConfigManager.GetConfigRegistry(ctx)
 	response = &szpb.GetConfigRegistryResponse{
@@ -102,6 +109,9 @@ func (server *SzConfigManagerServer) GetDefaultConfigId( //revive:disable var-na
 		defer func() { server.traceExit(12, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzConfigManager.GetDefaultConfigId", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szConfigManager := getSzConfigManager()
 
 	result, err = szConfigManager.GetDefaultConfigID(ctx)
@@ -134,6 +144,9 @@ func (server *SzConfigManagerServer) GetTemplateConfig(
 		defer func() { server.traceExit(99, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzConfigManager.GetTemplateConfig", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szConfigManager := getSzConfigManager()
 
 	szConfig, err := szConfigManager.CreateConfigFromTemplate(ctx)
@@ -167,6 +180,9 @@ func (server *SzConfigManagerServer) RegisterConfig(
 		defer func() { server.traceExit(2, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzConfigManager.RegisterConfig", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szConfigManager := getSzConfigManager()
 	result, err = szConfigManager.RegisterConfig(ctx, request.GetConfigDefinition(), request.GetConfigComment())
 	response = &szpb.RegisterConfigResponse{
@@ -193,6 +209,9 @@ func (server *SzConfigManagerServer) ReplaceDefaultConfigId(
 		defer func() { server.traceExit(20, request, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzConfigManager.ReplaceDefaultConfigId", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szConfigManager := getSzConfigManager()
 	err = szConfigManager.ReplaceDefaultConfigID(
 		ctx,
@@ -221,6 +240,9 @@ func (server *SzConfigManagerServer) SetDefaultConfig(
 		defer func() { server.traceExit(22, request, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzConfigManager.SetDefaultConfig", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szConfigManager := getSzConfigManager()
 	result, err := szConfigManager.SetDefaultConfig(ctx, request.GetConfigDefinition(), request.GetConfigComment())
 	response = &szpb.SetDefaultConfigResponse{
@@ -247,6 +269,9 @@ func (server *SzConfigManagerServer) SetDefaultConfigId(
 		defer func() { server.traceExit(22, request, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzConfigManager.SetDefaultConfigId", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szConfigManager := getSzConfigManager()
 	err = szConfigManager.SetDefaultConfigID(ctx, request.GetConfigId())
 	response = &szpb.SetDefaultConfigIdResponse{}
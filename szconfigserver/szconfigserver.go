@@ -8,6 +8,7 @@ import (
 	"github.com/senzing-garage/go-helpers/wraperror"
 	"github.com/senzing-garage/go-logging/logging"
 	szobserver "github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/serve-grpc/tracing"
 	"github.com/senzing-garage/sz-sdk-go-core/szconfig"
 	"github.com/senzing-garage/sz-sdk-go-core/szconfigmanager"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
@@ -43,6 +44,9 @@ func (server *SzConfigServer) RegisterDataSource(
 		defer func() { server.traceExit(2, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzConfig.RegisterDataSource", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szConfig, err := server.createSzConfig(ctx, request.GetConfigDefinition())
 	if err != nil {
 		return response, wraperror.Errorf(err, "createSzConfig")
@@ -79,6 +83,9 @@ func (server *SzConfigServer) UnregisterDataSource(
 		defer func() { server.traceExit(10, request, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzConfig.UnregisterDataSource", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szConfig, err := server.createSzConfig(ctx, request.GetConfigDefinition())
 	if err != nil {
 		return response, wraperror.Errorf(err, "createSzConfig")
@@ -116,6 +123,9 @@ func (server *SzConfigServer) GetDataSourceRegistry(
 		defer func() { server.traceExit(20, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzConfig.GetDataSourceRegistry", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szConfig, err := server.createSzConfig(ctx, request.GetConfigDefinition())
 	if err != nil {
 		return response, err
@@ -147,6 +157,9 @@ func (server *SzConfigServer) VerifyConfig(
 		defer func() { server.traceExit(999, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzConfig.VerifyConfig", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szConfig, err := server.createSzConfig(ctx, request.GetConfigDefinition())
 	if err != nil {
 		return response, err
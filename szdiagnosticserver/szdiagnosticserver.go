@@ -8,6 +8,7 @@ import (
 	"github.com/senzing-garage/go-helpers/wraperror"
 	"github.com/senzing-garage/go-logging/logging"
 	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/serve-grpc/tracing"
 	szsdk "github.com/senzing-garage/sz-sdk-go-core/szdiagnostic"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
 	szpb "github.com/senzing-garage/sz-sdk-proto/go/szdiagnostic"
@@ -42,6 +43,9 @@ func (server *SzDiagnosticServer) CheckRepositoryPerformance(
 		defer func() { server.traceExit(2, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzDiagnostic.CheckRepositoryPerformance", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szDiagnostic := getSzDiagnostic()
 	result, err = szDiagnostic.CheckRepositoryPerformance(ctx, int(request.GetSecondsToRun()))
 	response = &szpb.CheckRepositoryPerformanceResponse{
@@ -69,6 +73,9 @@ func (server *SzDiagnosticServer) GetRepositoryInfo(
 		defer func() { server.traceExit(2, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzDiagnostic.GetRepositoryInfo", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szDiagnostic := getSzDiagnostic()
 	result, err = szDiagnostic.GetRepositoryInfo(ctx)
 	response = &szpb.GetRepositoryInfoResponse{
@@ -94,6 +101,9 @@ func (server *SzDiagnosticServer) GetFeature(
 		defer func() { server.traceExit(2, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzDiagnostic.GetFeature", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szDiagnostic := getSzDiagnostic()
 	result, err = szDiagnostic.GetFeature(ctx, request.GetFeatureId())
 	response := szpb.GetFeatureResponse{
@@ -117,6 +127,9 @@ func (server *SzDiagnosticServer) PurgeRepository(
 		defer func() { server.traceExit(118, request, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzDiagnostic.PurgeRepository", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szDiagnostic := getSzDiagnostic()
 	err = szDiagnostic.PurgeRepository(ctx)
 	response := szpb.PurgeRepositoryResponse{}
@@ -138,6 +151,9 @@ func (server *SzDiagnosticServer) Reinitialize(
 		defer func() { server.traceExit(52, request, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzDiagnostic.Reinitialize", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szDiagnostic := getSzDiagnostic()
 	err = szDiagnostic.Reinitialize(ctx, request.GetConfigId())
 	response := szpb.ReinitializeResponse{}
@@ -1,6 +1,7 @@
 package szengineserver
 
 import (
+	"context"
 	"errors"
 
 	"github.com/senzing-garage/go-logging/logging"
@@ -14,8 +15,9 @@ import (
 // server is used to implement helloworld.GreeterServer.
 type SzEngineServer struct {
 	szpb.UnimplementedSzEngineServer
-	isTrace bool
-	logger  logging.Logging
+	ExportOwner func(ctx context.Context) string // Owner of exports opened by a caller; with nil or "", the export id suffices.
+	isTrace     bool
+	logger      logging.Logging
 }
 
 // ----------------------------------------------------------------------------
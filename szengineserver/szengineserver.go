@@ -2,13 +2,14 @@ package szengineserver
 
 import (
 	"context"
-	"math"
+	"errors"
 	"sync"
 	"time"
 
 	"github.com/senzing-garage/go-helpers/wraperror"
 	"github.com/senzing-garage/go-logging/logging"
 	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/serve-grpc/tracing"
 	szsdk "github.com/senzing-garage/sz-sdk-go-core/szengine"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
 	szpb "github.com/senzing-garage/sz-sdk-proto/go/szengine"
@@ -39,6 +40,9 @@ func (server *SzEngineServer) AddRecord(
 		defer func() { server.traceExit(2, request, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.AddRecord", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err := szEngine.AddRecord(
 		ctx,
@@ -68,17 +72,15 @@ func (server *SzEngineServer) CloseExportReport(
 		defer func() { server.traceExit(14, request, err, time.Since(entryTime)) }()
 	}
 
-	szEngine := getSzEngine()
-
-	exportHandle, convertErr := int64ToUintptr(request.GetExportHandle())
-	if convertErr != nil {
-		return &szpb.CloseExportReportResponse{}, wraperror.Errorf(convertErr, wraperror.NoMessage)
-	}
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.CloseExportReport", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
 
-	err = szEngine.CloseExportReport(ctx, exportHandle)
+	err = exports.use(request.GetExportHandle(), server.exportOwner(ctx), func(entry *exportEntry) error {
+		return exports.closeLocked(ctx, entry)
+	})
 	response := szpb.CloseExportReportResponse{}
 
-	return &response, wraperror.Errorf(err, wraperror.NoMessage)
+	return &response, err
 }
 
 func (server *SzEngineServer) CountRedoRecords(
@@ -97,6 +99,9 @@ func (server *SzEngineServer) CountRedoRecords(
 		defer func() { server.traceExit(16, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.CountRedoRecords", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.CountRedoRecords(ctx)
 	response := szpb.CountRedoRecordsResponse{
@@ -120,6 +125,9 @@ func (server *SzEngineServer) DeleteRecord(
 		defer func() { server.traceExit(18, request, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.DeleteRecord", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err := szEngine.DeleteRecord(ctx, request.GetDataSourceCode(), request.GetRecordId(), request.GetFlags())
 	response := szpb.DeleteRecordResponse{
@@ -135,7 +143,7 @@ func (server *SzEngineServer) ExportCsvEntityReport(
 ) (*szpb.ExportCsvEntityReportResponse, error) {
 	var err error
 
-	var result uintptr
+	var result int64
 
 	if server.isTrace {
 		entryTime := time.Now()
@@ -145,16 +153,22 @@ func (server *SzEngineServer) ExportCsvEntityReport(
 		defer func() { server.traceExit(28, request, result, err, time.Since(entryTime)) }()
 	}
 
-	szEngine := getSzEngine()
-	result, err = szEngine.ExportCsvEntityReport(ctx, request.GetCsvColumnList(), request.GetFlags())
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.ExportCsvEntityReport", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
 
-	responseResult, convertErr := uintptrToInt64(result)
-	if convertErr != nil {
-		return &szpb.ExportCsvEntityReportResponse{}, wraperror.Errorf(convertErr, wraperror.NoMessage)
-	}
+	szEngine := getSzEngine()
+	exportInfo := ExportInfo{
+		CsvColumnList: request.GetCsvColumnList(),
+		Flags:         request.GetFlags(),
+		Format:        ExportFormatCsv,
+		Owner:         server.exportOwner(ctx),
+	}
+	result, err = exports.open(exportInfo, func() (uintptr, error) {
+		return szEngine.ExportCsvEntityReport(ctx, request.GetCsvColumnList(), request.GetFlags())
+	})
 
 	response := szpb.ExportCsvEntityReportResponse{
-		Result: responseResult,
+		Result: result,
 	}
 
 	return &response, wraperror.Errorf(err, wraperror.NoMessage)
@@ -166,7 +180,7 @@ func (server *SzEngineServer) ExportJsonEntityReport( //revive:disable-line var-
 ) (*szpb.ExportJsonEntityReportResponse, error) {
 	var err error
 
-	var result uintptr
+	var result int64
 
 	if server.isTrace {
 		entryTime := time.Now()
@@ -176,16 +190,21 @@ func (server *SzEngineServer) ExportJsonEntityReport( //revive:disable-line var-
 		defer func() { server.traceExit(30, request, result, err, time.Since(entryTime)) }()
 	}
 
-	szEngine := getSzEngine()
-	result, err = szEngine.ExportJSONEntityReport(ctx, request.GetFlags())
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.ExportJsonEntityReport", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
 
-	responseResult, convertErr := uintptrToInt64(result)
-	if convertErr != nil {
-		return &szpb.ExportJsonEntityReportResponse{}, wraperror.Errorf(convertErr, wraperror.NoMessage)
+	szEngine := getSzEngine()
+	exportInfo := ExportInfo{
+		Flags:  request.GetFlags(),
+		Format: ExportFormatJSON,
+		Owner:  server.exportOwner(ctx),
 	}
+	result, err = exports.open(exportInfo, func() (uintptr, error) {
+		return szEngine.ExportJSONEntityReport(ctx, request.GetFlags())
+	})
 
 	response := szpb.ExportJsonEntityReportResponse{
-		Result: responseResult,
+		Result: result,
 	}
 
 	return &response, wraperror.Errorf(err, wraperror.NoMessage)
@@ -207,14 +226,26 @@ func (server *SzEngineServer) FetchNext(
 		defer func() { server.traceExit(32, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.FetchNext", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 
-	exportHandle, convertErr := int64ToUintptr(request.GetExportHandle())
-	if convertErr != nil {
-		return &szpb.FetchNextResponse{}, wraperror.Errorf(convertErr, wraperror.NoMessage)
+	var fetchErr error
+
+	err = exports.use(request.GetExportHandle(), server.exportOwner(ctx), func(entry *exportEntry) error {
+		result, fetchErr = szEngine.FetchNext(ctx, entry.handle)
+		if len(result) > 0 {
+			entry.info.RowsFetched++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	result, err = szEngine.FetchNext(ctx, exportHandle)
+	err = fetchErr
 	response := szpb.FetchNextResponse{
 		Result: result,
 	}
@@ -238,6 +269,9 @@ func (server *SzEngineServer) FindInterestingEntitiesByEntityId( //revive:disabl
 		defer func() { server.traceExit(34, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.FindInterestingEntitiesByEntityId", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.FindInterestingEntitiesByEntityID(ctx, request.GetEntityId(), request.GetFlags())
 	response := szpb.FindInterestingEntitiesByEntityIdResponse{
@@ -263,6 +297,9 @@ func (server *SzEngineServer) FindInterestingEntitiesByRecordId( //revive:disabl
 		defer func() { server.traceExit(36, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.FindInterestingEntitiesByRecordId", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.FindInterestingEntitiesByRecordID(
 		ctx,
@@ -293,6 +330,9 @@ func (server *SzEngineServer) FindNetworkByEntityId( //revive:disable-line var-n
 		defer func() { server.traceExit(38, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.FindNetworkByEntityId", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.FindNetworkByEntityID(
 		ctx,
@@ -325,6 +365,9 @@ func (server *SzEngineServer) FindNetworkByRecordId( //revive:disable-line var-n
 		defer func() { server.traceExit(42, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.FindNetworkByRecordId", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.FindNetworkByRecordID(
 		ctx,
@@ -357,6 +400,9 @@ func (server *SzEngineServer) FindPathByEntityId( //revive:disable-line var-nami
 		defer func() { server.traceExit(46, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.FindPathByEntityId", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.FindPathByEntityID(
 		ctx,
@@ -390,6 +436,9 @@ func (server *SzEngineServer) FindPathByRecordId( //revive:disable-line var-nami
 		defer func() { server.traceExit(50, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.FindPathByRecordId", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.FindPathByRecordID(
 		ctx,
@@ -425,6 +474,9 @@ func (server *SzEngineServer) GetActiveConfigId( //revive:disable-line var-namin
 		defer func() { server.traceExit(70, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.GetActiveConfigId", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.GetActiveConfigID(ctx)
 	response := szpb.GetActiveConfigIdResponse{
@@ -450,6 +502,9 @@ func (server *SzEngineServer) GetEntityByEntityId( //revive:disable-line var-nam
 		defer func() { server.traceExit(72, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.GetEntityByEntityId", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.GetEntityByEntityID(ctx, request.GetEntityId(), request.GetFlags())
 	response := szpb.GetEntityByEntityIdResponse{
@@ -475,6 +530,9 @@ func (server *SzEngineServer) GetEntityByRecordId( //revive:disable-line var-nam
 		defer func() { server.traceExit(76, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.GetEntityByRecordId", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.GetEntityByRecordID(
 		ctx,
@@ -505,6 +563,9 @@ func (server *SzEngineServer) GetRecord(
 		defer func() { server.traceExit(84, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.GetRecord", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.GetRecord(ctx, request.GetDataSourceCode(), request.GetRecordId(), request.GetFlags())
 	response := szpb.GetRecordResponse{
@@ -530,6 +591,9 @@ func (server *SzEngineServer) GetRedoRecord(
 		defer func() { server.traceExit(88, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.GetRedoRecord", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.GetRedoRecord(ctx)
 	response := szpb.GetRedoRecordResponse{
@@ -555,6 +619,9 @@ func (server *SzEngineServer) GetStats(
 		defer func() { server.traceExit(140, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.GetStats", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.GetStats(ctx)
 	response := szpb.GetStatsResponse{
@@ -580,6 +647,9 @@ func (server *SzEngineServer) GetVirtualEntityByRecordId( //revive:disable-line
 		defer func() { server.traceExit(92, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.GetVirtualEntityByRecordId", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.GetVirtualEntityByRecordID(ctx, request.GetRecordKeys(), request.GetFlags())
 	response := szpb.GetVirtualEntityByRecordIdResponse{
@@ -605,6 +675,9 @@ func (server *SzEngineServer) HowEntityByEntityId( //revive:disable-line var-nam
 		defer func() { server.traceExit(96, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.HowEntityByEntityId", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.HowEntityByEntityID(ctx, request.GetEntityId(), request.GetFlags())
 	response := szpb.HowEntityByEntityIdResponse{
@@ -628,6 +701,9 @@ func (server *SzEngineServer) GetRecordPreview(
 		defer func() { server.traceExit(166, request, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.GetRecordPreview", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err := szEngine.GetRecordPreview(ctx, request.GetRecordDefinition(), request.GetFlags())
 	response := szpb.GetRecordPreviewResponse{
@@ -651,6 +727,9 @@ func (server *SzEngineServer) PrimeEngine(
 		defer func() { server.traceExit(104, request, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.PrimeEngine", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	err = szEngine.PrimeEngine(ctx)
 	response := szpb.PrimeEngineResponse{}
@@ -673,6 +752,9 @@ func (server *SzEngineServer) ProcessRedoRecord(
 		defer func() { server.traceExit(999, request, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.ProcessRedoRecord", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err := szEngine.ProcessRedoRecord(ctx, request.GetRedoRecord(), request.GetFlags())
 	response := szpb.ProcessRedoRecordResponse{
@@ -698,6 +780,9 @@ func (server *SzEngineServer) ReevaluateEntity(
 		defer func() { server.traceExit(120, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.ReevaluateEntity", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.ReevaluateEntity(ctx, request.GetEntityId(), request.GetFlags())
 	response := szpb.ReevaluateEntityResponse{
@@ -723,6 +808,9 @@ func (server *SzEngineServer) ReevaluateRecord(
 		defer func() { server.traceExit(124, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.ReevaluateRecord", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.ReevaluateRecord(ctx, request.GetDataSourceCode(), request.GetRecordId(), request.GetFlags())
 	response := szpb.ReevaluateRecordResponse{
@@ -746,6 +834,9 @@ func (server *SzEngineServer) Reinitialize(
 		defer func() { server.traceExit(128, request, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.Reinitialize", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	err = szEngine.Reinitialize(ctx, request.GetConfigId())
 	response := szpb.ReinitializeResponse{}
@@ -769,6 +860,9 @@ func (server *SzEngineServer) SearchByAttributes(
 		defer func() { server.traceExit(134, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.SearchByAttributes", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.SearchByAttributes(
 		ctx,
@@ -791,7 +885,9 @@ func (server *SzEngineServer) StreamExportCsvEntityReport(
 		server.traceEntry(157, request)
 	}
 
-	ctx := stream.Context()
+	ctx, span := tracing.StartSdkSpan(stream.Context(), "SzEngine.StreamExportCsvEntityReport", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	entryTime := time.Now()
 	szEngine := getSzEngine()
 	rowsFetched := 0
@@ -806,9 +902,11 @@ func (server *SzEngineServer) StreamExportCsvEntityReport(
 	}
 
 	// Defer the CloseExportReport in case we exit early for any reason.
+	// The stream context is canceled when the client goes away, so close with a context that is not.
 
 	defer func() {
-		err = szEngine.CloseExportReport(ctx, queryHandle)
+		closeErr := szEngine.CloseExportReport(context.WithoutCancel(ctx), queryHandle)
+		err = errors.Join(err, wraperror.Errorf(closeErr, "CloseExportReport"))
 		if server.isTrace {
 			server.traceExit(158, request, rowsFetched, err, time.Since(entryTime))
 		}
@@ -819,6 +917,13 @@ func (server *SzEngineServer) StreamExportCsvEntityReport(
 	for {
 		var fetchResult string
 
+		// Stop as soon as the client goes away or the deadline passes.
+
+		err = ctx.Err()
+		if err != nil {
+			return wraperror.Errorf(err, "stream.Context")
+		}
+
 		fetchResult, err = szEngine.FetchNext(ctx, queryHandle)
 		if err != nil {
 			return wraperror.Errorf(err, "FetchNext")
@@ -853,24 +958,38 @@ func (server *SzEngineServer) StreamExportJsonEntityReport( //revive:disable-lin
 		server.traceEntry(159, request)
 	}
 
-	ctx := stream.Context()
+	ctx, span := tracing.StartSdkSpan(stream.Context(), "SzEngine.StreamExportJsonEntityReport", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	entryTime := time.Now()
 	szEngine := getSzEngine()
 	rowsFetched := 0
 
+	filter, err := entityReportFilterFromContext(ctx)
+	if err != nil {
+		return err //nolint:wrapcheck // InvalidArgument must reach the client.
+	}
+
+	flags := request.GetFlags()
+	if filter != nil {
+		flags = filter.ExportFlags(flags)
+	}
+
 	// Get the query handle.
 
 	var queryHandle uintptr
 
-	queryHandle, err = szEngine.ExportJSONEntityReport(ctx, request.GetFlags())
+	queryHandle, err = szEngine.ExportJSONEntityReport(ctx, flags)
 	if err != nil {
 		return wraperror.Errorf(err, "ExportJSONEntityReport")
 	}
 
 	// Defer the CloseExportReport in case we exit early for any reason.
+	// The stream context is canceled when the client goes away, so close with a context that is not.
 
 	defer func() {
-		err = szEngine.CloseExportReport(ctx, queryHandle)
+		closeErr := szEngine.CloseExportReport(context.WithoutCancel(ctx), queryHandle)
+		err = errors.Join(err, wraperror.Errorf(closeErr, "CloseExportReport"))
 		if server.isTrace {
 			server.traceExit(160, request, rowsFetched, err, time.Since(entryTime))
 		}
@@ -881,6 +1000,13 @@ func (server *SzEngineServer) StreamExportJsonEntityReport( //revive:disable-lin
 	for {
 		var fetchResult string
 
+		// Stop as soon as the client goes away or the deadline passes.
+
+		err = ctx.Err()
+		if err != nil {
+			return wraperror.Errorf(err, "stream.Context")
+		}
+
 		fetchResult, err = szEngine.FetchNext(ctx, queryHandle)
 		if err != nil {
 			return wraperror.Errorf(err, "FetchNext")
@@ -890,6 +1016,21 @@ func (server *SzEngineServer) StreamExportJsonEntityReport( //revive:disable-lin
 			break
 		}
 
+		rowsFetched++
+
+		if filter != nil {
+			var isKept bool
+
+			fetchResult, isKept, err = filter.Apply(fetchResult)
+			if err != nil {
+				return wraperror.Errorf(err, "Apply")
+			}
+
+			if !isKept {
+				continue
+			}
+		}
+
 		response := szpb.StreamExportJsonEntityReportResponse{
 			Result: fetchResult,
 		}
@@ -898,8 +1039,6 @@ func (server *SzEngineServer) StreamExportJsonEntityReport( //revive:disable-lin
 		}
 
 		server.traceEntry(602, request, fetchResult)
-
-		rowsFetched++
 	}
 
 	err = nil
@@ -923,6 +1062,9 @@ func (server *SzEngineServer) WhyEntities(
 		defer func() { server.traceExit(142, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.WhyEntities", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.WhyEntities(ctx, request.GetEntityId_1(), request.GetEntityId_2(), request.GetFlags())
 	response := szpb.WhyEntitiesResponse{
@@ -948,6 +1090,9 @@ func (server *SzEngineServer) WhyRecordInEntity(
 		defer func() { server.traceExit(154, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.WhyRecordInEntity", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.WhyRecordInEntity(
 		ctx,
@@ -978,6 +1123,9 @@ func (server *SzEngineServer) WhyRecords(
 		defer func() { server.traceExit(154, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.WhyRecords", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.WhyRecords(
 		ctx,
@@ -1010,6 +1158,9 @@ func (server *SzEngineServer) WhySearch(
 		defer func() { server.traceExit(168, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzEngine.WhySearch", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szEngine := getSzEngine()
 	result, err = szEngine.WhySearch(
 		ctx,
@@ -1097,24 +1248,6 @@ func (server *SzEngineServer) SetLogLevel(ctx context.Context, logLevelName stri
 // 	return server.getLogger().NewError(messageNumber, details...)
 // }
 
-// --- Conversions ------------------------------------------------------------
-
-func int64ToUintptr(value int64) (uintptr, error) {
-	if value < 0 {
-		return 0, wraperror.Errorf(errPackage, "cannot convert negative int64 (%d) to uintptr", value)
-	}
-
-	return uintptr(value), nil
-}
-
-func uintptrToInt64(value uintptr) (int64, error) {
-	if uint64(value) > math.MaxInt64 {
-		return 0, wraperror.Errorf(errPackage, "uintptr value %d overflows int64", value)
-	}
-
-	return int64(value), nil
-}
-
 // --- Services ---------------------------------------------------------------
 
 // Singleton pattern for szconfig.
@@ -0,0 +1,265 @@
+package szengineserver
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// ExportInfo describes an export opened with ExportCsvEntityReport or ExportJsonEntityReport.
+type ExportInfo struct {
+	CreatedAt     time.Time
+	CsvColumnList string
+	Flags         int64
+	Format        string // ExportFormatCsv or ExportFormatJSON.
+	ID            int64  // The export handle given to the client.
+	LastAccess    time.Time
+	Owner         string
+	RowsFetched   int64
+}
+
+// exportEntry is an open export.  Its mutex serializes FetchNext and CloseExportReport.
+type exportEntry struct {
+	handle uintptr
+	info   ExportInfo
+	isOpen bool
+	mutex  sync.Mutex
+}
+
+// exportRegistry maps the export ids given to clients to Senzing export handles.
+type exportRegistry struct {
+	entries map[int64]*exportEntry
+	mutex   sync.Mutex
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	ExportFormatCsv  = "csv"
+	ExportFormatJSON = "json"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var exports = &exportRegistry{entries: map[int64]*exportEntry{}}
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The CloseIdleExports function closes exports that nobody has fetched from or closed
+for longer than idleTTL, so that clients which go away do not leak Senzing export handles.
+
+Input
+  - ctx: A context to control lifecycle.
+  - idleTTL: How long an export may go unused.
+
+Output
+  - The exports that were closed.
+*/
+func CloseIdleExports(ctx context.Context, idleTTL time.Duration) ([]ExportInfo, error) {
+	cutoff := time.Now().Add(-idleTTL)
+
+	return exports.closeWhere(ctx, func(info ExportInfo) bool { return info.LastAccess.Before(cutoff) })
+}
+
+/*
+The CloseAllExports function closes every open export.  Call it before destroying the SzEngine.
+*/
+func CloseAllExports(ctx context.Context) error {
+	_, err := exports.closeWhere(ctx, func(ExportInfo) bool { return true })
+
+	return err
+}
+
+/*
+The GetExport function describes one open export.
+
+Output
+  - The ExportInfo and true, or false if no export has that id.
+*/
+func GetExport(exportID int64) (ExportInfo, bool) {
+	exports.mutex.Lock()
+	entry, isFound := exports.entries[exportID]
+	exports.mutex.Unlock()
+
+	if !isFound {
+		return ExportInfo{}, false
+	}
+
+	entry.mutex.Lock()
+	defer entry.mutex.Unlock()
+
+	return entry.info, entry.isOpen
+}
+
+/*
+The ListExports function describes the open exports, oldest first.
+*/
+func ListExports() []ExportInfo {
+	exports.mutex.Lock()
+	entries := make([]*exportEntry, 0, len(exports.entries))
+
+	for _, entry := range exports.entries {
+		entries = append(entries, entry)
+	}
+	exports.mutex.Unlock()
+
+	result := make([]ExportInfo, 0, len(entries))
+
+	for _, entry := range entries {
+		entry.mutex.Lock()
+		if entry.isOpen {
+			result = append(result, entry.info)
+		}
+		entry.mutex.Unlock()
+	}
+
+	slices.SortFunc(result, func(a, b ExportInfo) int { return a.CreatedAt.Compare(b.CreatedAt) })
+
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+/*
+Get the owner of exports opened or used by the caller of ctx.  Without one, the
+export id, which cannot be guessed, is all a caller needs to use an export.
+*/
+func (server *SzEngineServer) exportOwner(ctx context.Context) string {
+	if server.ExportOwner == nil {
+		return ""
+	}
+
+	return server.ExportOwner(ctx)
+}
+
+// Open an export with open and give it an unguessable id.
+func (registry *exportRegistry) open(info ExportInfo, open func() (uintptr, error)) (int64, error) {
+	handle, err := open()
+	if err != nil {
+		return 0, err //nolint:wrapcheck // The caller wraps SDK errors as its own.
+	}
+
+	now := time.Now()
+	info.CreatedAt = now
+	info.LastAccess = now
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	for {
+		info.ID = newExportID()
+		if _, isTaken := registry.entries[info.ID]; !isTaken {
+			break
+		}
+	}
+
+	registry.entries[info.ID] = &exportEntry{handle: handle, info: info, isOpen: true}
+
+	return info.ID, nil
+}
+
+/*
+Run use with the Senzing handle of an export owned by owner, holding the export's lock.
+Unknown exports and exports of other owners are both reported as NotFound, so that
+callers cannot probe for other clients' exports.
+*/
+func (registry *exportRegistry) use(exportID int64, owner string, use func(entry *exportEntry) error) error {
+	registry.mutex.Lock()
+	entry, isFound := registry.entries[exportID]
+	registry.mutex.Unlock()
+
+	if isFound {
+		entry.mutex.Lock()
+		defer entry.mutex.Unlock()
+	}
+
+	if !isFound || !entry.isOpen || entry.info.Owner != owner {
+		return status.Errorf(codes.NotFound, "export %d is not open", exportID)
+	}
+
+	entry.info.LastAccess = time.Now()
+
+	return use(entry)
+}
+
+// Close the exports that match, waiting for any FetchNext in progress on them.
+func (registry *exportRegistry) closeWhere(ctx context.Context, matches func(ExportInfo) bool) ([]ExportInfo, error) {
+	var (
+		closed []ExportInfo
+		err    error
+	)
+
+	registry.mutex.Lock()
+	entries := make([]*exportEntry, 0, len(registry.entries))
+
+	for _, entry := range registry.entries {
+		entries = append(entries, entry)
+	}
+	registry.mutex.Unlock()
+
+	for _, entry := range entries {
+		entry.mutex.Lock()
+
+		if entry.isOpen && matches(entry.info) {
+			err = errors.Join(err, registry.closeLocked(ctx, entry))
+			closed = append(closed, entry.info)
+		}
+
+		entry.mutex.Unlock()
+	}
+
+	return closed, wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+// Close the Senzing handle of entry, whose lock is held, and forget the entry.
+func (registry *exportRegistry) closeLocked(ctx context.Context, entry *exportEntry) error {
+	entry.isOpen = false
+
+	registry.mutex.Lock()
+	delete(registry.entries, entry.info.ID)
+	registry.mutex.Unlock()
+
+	err := getSzEngine().CloseExportReport(ctx, entry.handle)
+
+	return wraperror.Errorf(err, "CloseExportReport")
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// A random positive int64.
+func newExportID() int64 {
+	var buffer [8]byte
+
+	for {
+		_, _ = rand.Read(buffer[:])
+
+		result := int64(binary.BigEndian.Uint64(buffer[:]) & math.MaxInt64) //nolint:gosec // Masked to 63 bits.
+		if result != 0 {
+			return result
+		}
+	}
+}
@@ -0,0 +1,367 @@
+package szengineserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+EntityReportFilter selects and trims the rows of ExportJsonEntityReport on the server,
+so that clients do not receive whole entities only to discard most of them.
+
+An entity is kept when it has a record from one of DataSources, has at least
+MinRecordCount records, and has an ENTITY_ID from MinEntityID to MaxEntityID.
+Zero values do not filter.
+
+Fields lists the paths of the values to keep from each row, for example
+"RESOLVED_ENTITY.ENTITY_ID" or "$.RESOLVED_ENTITY.RECORDS[*].RECORD_ID".  Paths are
+a subset of JSONPath: dot-separated member names, optionally starting with "$" and
+with "[*]" after arrays.  Arrays are traversed whether or not "[*]" is given.
+Kept values stay at their place in the row.  Empty Fields keeps the whole row.
+
+SzEngine.StreamExportJsonEntityReport reads the filter from the Export*MetadataKey
+metadata of the call.
+*/
+type EntityReportFilter struct {
+	DataSources          []string
+	Fields               []string
+	MaxEntityID          int64
+	MinEntityID          int64
+	MinRecordCount       int64
+	fieldPaths           [][]string
+	isRecordSummaryAdded bool // RECORD_SUMMARY was added by ExportFlags, so Apply removes it.
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// gRPC metadata keys of the EntityReportFilter of SzEngine.StreamExportJsonEntityReport.
+// Data sources and fields may be repeated or separated by commas.
+const (
+	ExportDataSourceMetadataKey     = "x-senzing-export-data-source"
+	ExportFieldMetadataKey          = "x-senzing-export-field"
+	ExportMaxEntityIDMetadataKey    = "x-senzing-export-max-entity-id"
+	ExportMinEntityIDMetadataKey    = "x-senzing-export-min-entity-id"
+	ExportMinRecordCountMetadataKey = "x-senzing-export-min-record-count"
+)
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+The Validate method checks the Fields paths.  It must be called before Apply.
+*/
+func (filter *EntityReportFilter) Validate() error {
+	filter.fieldPaths = make([][]string, 0, len(filter.Fields))
+
+	for _, field := range filter.Fields {
+		fieldPath, err := parseFieldPath(field)
+		if err != nil {
+			return err
+		}
+
+		filter.fieldPaths = append(filter.fieldPaths, fieldPath)
+	}
+
+	if filter.MaxEntityID > 0 && filter.MaxEntityID < filter.MinEntityID {
+		return wraperror.Errorf(errPackage, "max entity id %d is less than min entity id %d",
+			filter.MaxEntityID, filter.MinEntityID)
+	}
+
+	return nil
+}
+
+/*
+The ExportFlags method returns the client's export flags plus those the filter needs.
+DataSources and MinRecordCount are checked in RECORD_SUMMARY, which Apply removes
+again if flags did not ask for it.  Call it once per export, before Apply.
+*/
+func (filter *EntityReportFilter) ExportFlags(flags int64) int64 {
+	filter.isRecordSummaryAdded = false
+
+	if len(filter.DataSources) > 0 || filter.MinRecordCount > 0 {
+		filter.isRecordSummaryAdded = flags&senzing.SzEntityIncludeRecordSummary == 0
+
+		return flags | senzing.SzEntityIncludeRecordSummary
+	}
+
+	return flags
+}
+
+/*
+The Apply method filters and projects one row of ExportJsonEntityReport.
+
+Output
+  - The projected row.
+  - False if the filter drops the row.
+*/
+func (filter *EntityReportFilter) Apply(row string) (string, bool, error) {
+	decoder := json.NewDecoder(strings.NewReader(row))
+	decoder.UseNumber()
+
+	var entity map[string]any
+
+	err := decoder.Decode(&entity)
+	if err != nil {
+		return "", false, wraperror.Errorf(err, "Decode")
+	}
+
+	if !filter.matches(entity) {
+		return "", false, nil
+	}
+
+	if filter.isRecordSummaryAdded {
+		resolvedEntity, _ := entity["RESOLVED_ENTITY"].(map[string]any)
+		delete(resolvedEntity, "RECORD_SUMMARY")
+	}
+
+	if len(filter.fieldPaths) == 0 {
+		if !filter.isRecordSummaryAdded {
+			return row, true, nil
+		}
+
+		return encodeRow(entity, row)
+	}
+
+	var projected any = map[string]any{}
+
+	for _, fieldPath := range filter.fieldPaths {
+		value, isFound := project(entity, fieldPath)
+		if isFound {
+			projected = merge(projected, value)
+		}
+	}
+
+	return encodeRow(projected, row)
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (filter *EntityReportFilter) matches(entity map[string]any) bool {
+	resolvedEntity, _ := entity["RESOLVED_ENTITY"].(map[string]any)
+
+	entityID, _ := jsonInt64(resolvedEntity["ENTITY_ID"])
+	if entityID < filter.MinEntityID || (filter.MaxEntityID > 0 && entityID > filter.MaxEntityID) {
+		return false
+	}
+
+	if len(filter.DataSources) == 0 && filter.MinRecordCount <= 0 {
+		return true
+	}
+
+	var (
+		hasDataSource = len(filter.DataSources) == 0
+		recordCount   int64
+	)
+
+	recordSummary, _ := resolvedEntity["RECORD_SUMMARY"].([]any)
+	for _, element := range recordSummary {
+		dataSource, _ := element.(map[string]any)
+		count, _ := jsonInt64(dataSource["RECORD_COUNT"])
+		recordCount += count
+
+		if code, _ := dataSource["DATA_SOURCE"].(string); slices.Contains(filter.DataSources, code) {
+			hasDataSource = true
+		}
+	}
+
+	return hasDataSource && recordCount >= filter.MinRecordCount
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+/*
+Get the filter from the Export*MetadataKey metadata of ctx, or nil if there is none.
+Invalid filters are reported as InvalidArgument.
+*/
+func entityReportFilterFromContext(ctx context.Context) (*EntityReportFilter, error) {
+	var (
+		err    error
+		result = &EntityReportFilter{}
+	)
+
+	incomingMetadata, _ := metadata.FromIncomingContext(ctx)
+	result.DataSources = metadataList(incomingMetadata, ExportDataSourceMetadataKey)
+	result.Fields = metadataList(incomingMetadata, ExportFieldMetadataKey)
+
+	for key, value := range map[string]*int64{
+		ExportMaxEntityIDMetadataKey:    &result.MaxEntityID,
+		ExportMinEntityIDMetadataKey:    &result.MinEntityID,
+		ExportMinRecordCountMetadataKey: &result.MinRecordCount,
+	} {
+		values := incomingMetadata.Get(key)
+		if len(values) == 0 {
+			continue
+		}
+
+		*value, err = strconv.ParseInt(strings.TrimSpace(values[0]), 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s: %q is not an integer", key, values[0])
+		}
+	}
+
+	if len(result.DataSources) == 0 && len(result.Fields) == 0 &&
+		result.MaxEntityID == 0 && result.MinEntityID == 0 && result.MinRecordCount == 0 {
+		return nil, nil //nolint:nilnil // No filter.
+	}
+
+	err = result.Validate()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return result, nil
+}
+
+// The values of a metadata key, split at commas.
+func metadataList(incomingMetadata metadata.MD, key string) []string {
+	var result []string
+
+	for _, value := range incomingMetadata.Get(key) {
+		for item := range strings.SplitSeq(value, ",") {
+			if item = strings.TrimSpace(item); len(item) > 0 {
+				result = append(result, item)
+			}
+		}
+	}
+
+	return result
+}
+
+// Encode value as JSON on one line, with the line ending of row.
+func encodeRow(value any, row string) (string, bool, error) {
+	var buffer bytes.Buffer
+
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+
+	err := encoder.Encode(value)
+	if err != nil {
+		return "", false, wraperror.Errorf(err, "Encode")
+	}
+
+	lineEnding := row[len(strings.TrimRight(row, "\r\n")):]
+
+	return strings.TrimSuffix(buffer.String(), "\n") + lineEnding, true, nil
+}
+
+// Split a path like "$.RESOLVED_ENTITY.RECORDS[*].RECORD_ID" into its member names.
+func parseFieldPath(field string) ([]string, error) {
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(field, "$"), ".")
+
+	result := strings.Split(trimmed, ".")
+	for index, name := range result {
+		name = strings.TrimSuffix(name, "[*]")
+		if len(name) == 0 || strings.ContainsAny(name, "[]*$") {
+			return nil, wraperror.Errorf(errPackage, "unsupported field path %q", field)
+		}
+
+		result[index] = name
+	}
+
+	return result, nil
+}
+
+// The parts of value on fieldPath, keeping the objects and arrays around them.
+func project(value any, fieldPath []string) (any, bool) {
+	if len(fieldPath) == 0 {
+		return value, true
+	}
+
+	switch typedValue := value.(type) {
+	case map[string]any:
+		member, isFound := typedValue[fieldPath[0]]
+		if !isFound {
+			return nil, false
+		}
+
+		projected, isFound := project(member, fieldPath[1:])
+		if !isFound {
+			return nil, false
+		}
+
+		return map[string]any{fieldPath[0]: projected}, true
+	case []any:
+		result := make([]any, len(typedValue))
+		isAnyFound := false
+
+		for index, element := range typedValue {
+			projected, isFound := project(element, fieldPath)
+			if isFound {
+				result[index] = projected
+				isAnyFound = true
+			} else {
+				result[index] = map[string]any{}
+			}
+		}
+
+		return result, isAnyFound
+	default:
+		return nil, false
+	}
+}
+
+// Combine two projections of the same row.
+func merge(target any, source any) any {
+	switch typedSource := source.(type) {
+	case map[string]any:
+		typedTarget, isMap := target.(map[string]any)
+		if !isMap {
+			return source
+		}
+
+		for name, value := range typedSource {
+			if existing, isFound := typedTarget[name]; isFound {
+				typedTarget[name] = merge(existing, value)
+			} else {
+				typedTarget[name] = value
+			}
+		}
+
+		return typedTarget
+	case []any:
+		typedTarget, isSlice := target.([]any)
+		if !isSlice || len(typedTarget) != len(typedSource) {
+			return source
+		}
+
+		for index := range typedSource {
+			typedTarget[index] = merge(typedTarget[index], typedSource[index])
+		}
+
+		return typedTarget
+	default:
+		return source
+	}
+}
+
+func jsonInt64(value any) (int64, bool) {
+	number, isNumber := value.(json.Number)
+	if !isNumber {
+		return 0, false
+	}
+
+	result, err := number.Int64()
+
+	return result, err == nil
+}
@@ -0,0 +1,163 @@
+package szengineserver_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/senzing-garage/go-helpers/record"
+	"github.com/senzing-garage/go-helpers/truthset"
+	"github.com/senzing-garage/serve-grpc/szengineserver"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	szpb "github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// testExportStream is a server stream that records the rows sent on it.
+type testExportStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	results []string
+}
+
+func (stream *testExportStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *testExportStream) Send(response *szpb.StreamExportJsonEntityReportResponse) error {
+	stream.results = append(stream.results, response.GetResult())
+
+	return nil
+}
+
+const testEntityReportRow = `{"RESOLVED_ENTITY":{"ENTITY_ID":100001,"ENTITY_NAME":"Robert Smith",` +
+	`"RECORD_SUMMARY":[{"DATA_SOURCE":"CUSTOMERS","RECORD_COUNT":2}],` +
+	`"RECORDS":[{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1001"},{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1002"}]},` +
+	`"RELATED_ENTITIES":[]}`
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestEntityReportFilter_Apply(test *testing.T) {
+	testCases := []struct {
+		name     string
+		filter   szengineserver.EntityReportFilter
+		expected string
+		isKept   bool
+	}{
+		{
+			name:     "dataSource",
+			filter:   szengineserver.EntityReportFilter{DataSources: []string{"REFERENCE", "CUSTOMERS"}},
+			expected: testEntityReportRow,
+			isKept:   true,
+		},
+		{
+			name:   "otherDataSource",
+			filter: szengineserver.EntityReportFilter{DataSources: []string{"REFERENCE"}},
+		},
+		{
+			name:   "minRecordCount",
+			filter: szengineserver.EntityReportFilter{MinRecordCount: 3},
+		},
+		{
+			name:   "entityIDRange",
+			filter: szengineserver.EntityReportFilter{MinEntityID: 1, MaxEntityID: 100000},
+		},
+		{
+			name: "fields",
+			filter: szengineserver.EntityReportFilter{
+				Fields: []string{"RESOLVED_ENTITY.ENTITY_ID", "$.RESOLVED_ENTITY.RECORDS[*].RECORD_ID", "MISSING"},
+			},
+			expected: `{"RESOLVED_ENTITY":{"ENTITY_ID":100001,"RECORDS":[{"RECORD_ID":"1001"},{"RECORD_ID":"1002"}]}}`,
+			isKept:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			err := testCase.filter.Validate()
+			require.NoError(test, err)
+
+			actual, isKept, err := testCase.filter.Apply(testEntityReportRow)
+			require.NoError(test, err)
+			require.Equal(test, testCase.isKept, isKept)
+
+			if isKept {
+				require.JSONEq(test, testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestEntityReportFilter_ExportFlags(test *testing.T) {
+	filter := szengineserver.EntityReportFilter{MinEntityID: 1}
+	require.Equal(test, senzing.SzExportIncludeAllEntities, filter.ExportFlags(senzing.SzExportIncludeAllEntities))
+
+	// RECORD_SUMMARY is added for the filter, then removed from the rows.
+
+	filter.DataSources = []string{"CUSTOMERS"}
+	require.NoError(test, filter.Validate())
+	require.Equal(test, senzing.SzEntityIncludeRecordSummary, filter.ExportFlags(senzing.SzNoFlags))
+
+	actual, isKept, err := filter.Apply(testEntityReportRow + "\n")
+	require.NoError(test, err)
+	require.True(test, isKept)
+	require.NotContains(test, actual, "RECORD_SUMMARY")
+	require.True(test, strings.HasSuffix(actual, "}\n"))
+
+	// RECORD_SUMMARY the client asked for is kept.
+
+	require.Equal(test, senzing.SzEntityIncludeRecordSummary, filter.ExportFlags(senzing.SzEntityIncludeRecordSummary))
+
+	actual, _, err = filter.Apply(testEntityReportRow)
+	require.NoError(test, err)
+	require.JSONEq(test, testEntityReportRow, actual)
+}
+
+func TestSzEngine_StreamExportJsonEntityReport_filter(test *testing.T) {
+	ctx := test.Context()
+	records := []record.Record{truthset.CustomerRecords["1001"], truthset.CustomerRecords["1002"]}
+
+	defer func() { deleteRecords(ctx, records) }()
+
+	addRecords(ctx, records)
+
+	incomingMetadata := metadata.Pairs(
+		szengineserver.ExportDataSourceMetadataKey, "CUSTOMERS",
+		szengineserver.ExportFieldMetadataKey, "RESOLVED_ENTITY.ENTITY_ID, RESOLVED_ENTITY.RECORD_SUMMARY",
+	)
+	stream := &testExportStream{ctx: metadata.NewIncomingContext(ctx, incomingMetadata)}
+	err := getTestObject(ctx, test).StreamExportJsonEntityReport(&szpb.StreamExportJsonEntityReportRequest{}, stream)
+	require.NoError(test, err)
+	require.NotEmpty(test, stream.results)
+
+	for _, result := range stream.results {
+		var row map[string]map[string]any
+
+		require.NoError(test, json.Unmarshal([]byte(result), &row))
+		require.Contains(test, row["RESOLVED_ENTITY"], "ENTITY_ID")
+		require.NotContains(test, row["RESOLVED_ENTITY"], "RECORD_SUMMARY")
+		require.NotContains(test, row["RESOLVED_ENTITY"], "ENTITY_NAME")
+	}
+
+	// Bad filters are refused.
+
+	incomingMetadata = metadata.Pairs(szengineserver.ExportMinRecordCountMetadataKey, "many")
+	stream = &testExportStream{ctx: metadata.NewIncomingContext(ctx, incomingMetadata)}
+	err = getTestObject(ctx, test).StreamExportJsonEntityReport(&szpb.StreamExportJsonEntityReportRequest{}, stream)
+	require.Equal(test, codes.InvalidArgument, status.Code(err))
+	require.Empty(test, stream.results)
+}
+
+func TestEntityReportFilter_Validate_badField(test *testing.T) {
+	for _, field := range []string{"", "RESOLVED_ENTITY..ENTITY_ID", "RESOLVED_ENTITY.RECORDS[0]", "$..ENTITY_ID"} {
+		filter := szengineserver.EntityReportFilter{Fields: []string{field}}
+		require.Error(test, filter.Validate(), field)
+	}
+}
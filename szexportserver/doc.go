@@ -0,0 +1,4 @@
+/*
+Package szexportserver handles gRPC requests for entity report exports that can be resumed after a dropped connection.
+*/
+package szexportserver
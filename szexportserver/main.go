@@ -0,0 +1,61 @@
+package szexportserver
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/go-logging/logging"
+	szpb "github.com/senzing-garage/serve-grpc/proto/go/szexport"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// SzExportServer implements the szexport.SzExport service using the szengineserver SzEngine singleton.
+type SzExportServer struct {
+	szpb.UnimplementedSzExportServer
+	CheckpointInterval int64 // Rows between resume tokens when the request does not say; 0 means DefaultCheckpointInterval.
+	isTrace            bool
+	logger             logging.Logging
+	ResumeTokenKey     []byte        // HMAC key signing resume tokens; empty means a random key, so tokens only work on this server.
+	ResumeTokenTTL     time.Duration // How long a resume token can be used; 0 means DefaultResumeTokenTTL.
+	tokenKey           []byte
+	tokenKeyOnce       sync.Once
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Identfier of the szexportserver package found messages having the format "senzing-6999xxxx".
+const ComponentID = 6018
+
+// Log message prefix.
+const Prefix = "serve-grpc.szexportserver."
+
+// Rows between resume tokens when neither the request nor the server says.
+const DefaultCheckpointInterval = 1000
+
+// How long a resume token can be used when ResumeTokenTTL is not set.
+const DefaultResumeTokenTTL = time.Hour
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Message templates for the szexportserver package.
+var IDMessages = map[int]string{
+	1: "Enter " + Prefix + "StreamCsvEntityReport(%+v).",
+	2: "Exit  " + Prefix + "StreamCsvEntityReport(%+v) sent %d rows and returned (%v).",
+	3: "Enter " + Prefix + "StreamJsonEntityReport(%+v).",
+	4: "Exit  " + Prefix + "StreamJsonEntityReport(%+v) sent %d rows and returned (%v).",
+	5: "Enter " + Prefix + "SetLogLevel(%s).",
+	6: "Exit  " + Prefix + "SetLogLevel(%s) returned (%v).",
+}
+
+// Status strings for specific szexportserver messages.
+var IDStatuses = map[int]string{}
+
+var errPackage = errors.New("szexportserver")
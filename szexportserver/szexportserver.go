@@ -0,0 +1,448 @@
+package szexportserver
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/go-logging/logging"
+	szpb "github.com/senzing-garage/serve-grpc/proto/go/szexport"
+	"github.com/senzing-garage/serve-grpc/szengineserver"
+	"github.com/senzing-garage/serve-grpc/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// An entityReport says which export a resume token belongs to.
+type entityReport struct {
+	CsvColumnList string `json:"c,omitempty"`
+	Filter        string `json:"x,omitempty"` // Digest of the filter and fields.
+	Flags         int64  `json:"f"`
+	Format        string `json:"r"`
+}
+
+// The signed content of a resume token.
+type resumeToken struct {
+	entityReport
+
+	Expires  int64 `json:"e"`
+	Sequence int64 `json:"s"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const OptionCallerSkip = 3
+
+const (
+	formatCsv  = "csv"
+	formatJSON = "json"
+)
+
+// Bytes of the SHA-256 of a filter kept in resume tokens.
+const filterDigestSize = 12
+
+// ----------------------------------------------------------------------------
+// Interface methods for github.com/senzing-garage/serve-grpc/proto/go/szexport.SzExportServer
+// ----------------------------------------------------------------------------
+
+/*
+The StreamCsvEntityReport method streams the rows of ExportCsvEntityReport, starting
+after the row of request.ResumeToken if it is set.
+*/
+func (server *SzExportServer) StreamCsvEntityReport(
+	request *szpb.StreamCsvEntityReportRequest,
+	stream szpb.SzExport_StreamCsvEntityReportServer,
+) error {
+	var (
+		err      error
+		rowsSent int64
+	)
+
+	if server.isTrace {
+		entryTime := time.Now()
+
+		server.traceEntry(1, request)
+
+		defer func() { server.traceExit(2, request, rowsSent, err, time.Since(entryTime)) }()
+	}
+
+	ctx, span := tracing.StartSdkSpan(stream.Context(), "SzExport.StreamCsvEntityReport", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
+	report := entityReport{CsvColumnList: request.GetCsvColumnList(), Flags: request.GetFlags(), Format: formatCsv}
+	openExport := func() (uintptr, error) {
+		return szengineserver.GetSdkSzEngine().ExportCsvEntityReport(ctx, request.GetCsvColumnList(), request.GetFlags())
+	}
+
+	rowsSent, err = server.streamEntityReport(
+		ctx,
+		report,
+		request.GetResumeToken(),
+		request.GetCheckpointInterval(),
+		openExport,
+		nil,
+		stream.Send,
+	)
+
+	return err
+}
+
+/*
+The StreamJsonEntityReport method streams the rows of ExportJsonEntityReport, starting
+after the row of request.ResumeToken if it is set.  Rows are filtered by request.Filter
+and trimmed to request.Fields.
+*/
+func (server *SzExportServer) StreamJsonEntityReport( //revive:disable-line var-naming
+	request *szpb.StreamJsonEntityReportRequest,
+	stream szpb.SzExport_StreamJsonEntityReportServer,
+) error {
+	var (
+		err      error
+		rowsSent int64
+	)
+
+	if server.isTrace {
+		entryTime := time.Now()
+
+		server.traceEntry(3, request)
+
+		defer func() { server.traceExit(4, request, rowsSent, err, time.Since(entryTime)) }()
+	}
+
+	ctx, span := tracing.StartSdkSpan(stream.Context(), "SzExport.StreamJsonEntityReport", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
+	filter, err := newEntityReportFilter(request)
+	if err != nil {
+		return err
+	}
+
+	report := entityReport{Filter: filterDigest(filter), Flags: request.GetFlags(), Format: formatJSON}
+	openExport := func() (uintptr, error) {
+		flags := request.GetFlags()
+		if filter != nil {
+			flags = filter.ExportFlags(flags)
+		}
+
+		return szengineserver.GetSdkSzEngine().ExportJSONEntityReport(ctx, flags)
+	}
+
+	rowsSent, err = server.streamEntityReport(
+		ctx,
+		report,
+		request.GetResumeToken(),
+		request.GetCheckpointInterval(),
+		openExport,
+		filter,
+		stream.Send,
+	)
+
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+/*
+Run an export and send its rows, skipping those up to and including the row of
+encodedToken and those that filter, if not nil, drops.  Resume tokens are attached
+to every checkpointInterval-th row of the export or, when filtered, to every
+checkpointInterval-th row sent, so that dropped rows do not also drop checkpoints.
+
+Errors about the token are gRPC status errors, returned unwrapped so their codes
+reach the client.
+
+Output
+  - The number of rows sent.
+*/
+func (server *SzExportServer) streamEntityReport(
+	ctx context.Context,
+	report entityReport,
+	encodedToken string,
+	checkpointInterval int64,
+	openExport func() (uintptr, error),
+	filter *szengineserver.EntityReportFilter,
+	send func(*szpb.StreamEntityReportResponse) error,
+) (rowsSent int64, err error) {
+	skipThrough := int64(-1)
+
+	if len(encodedToken) > 0 {
+		token, tokenErr := server.decodeResumeToken(encodedToken, report)
+		if tokenErr != nil {
+			return 0, tokenErr
+		}
+
+		skipThrough = token.Sequence
+	}
+
+	if checkpointInterval <= 0 {
+		checkpointInterval = server.getCheckpointInterval()
+	}
+
+	szEngine := szengineserver.GetSdkSzEngine()
+
+	queryHandle, err := openExport()
+	if err != nil {
+		return 0, wraperror.Errorf(err, "Export%sEntityReport", strings.ToUpper(report.Format))
+	}
+
+	// The stream context is canceled when the client goes away, so close with a context that is not.
+
+	defer func() {
+		closeErr := szEngine.CloseExportReport(context.WithoutCancel(ctx), queryHandle)
+		err = errors.Join(err, wraperror.Errorf(closeErr, "CloseExportReport"))
+	}()
+
+	for sequence := int64(0); ; sequence++ {
+		err = ctx.Err()
+		if err != nil {
+			return rowsSent, wraperror.Errorf(err, "stream.Context")
+		}
+
+		var fetchResult string
+
+		fetchResult, err = szEngine.FetchNext(ctx, queryHandle)
+		if err != nil {
+			return rowsSent, wraperror.Errorf(err, "FetchNext")
+		}
+
+		if len(fetchResult) == 0 {
+			return rowsSent, nil
+		}
+
+		if sequence <= skipThrough {
+			continue
+		}
+
+		if filter != nil {
+			var isKept bool
+
+			fetchResult, isKept, err = filter.Apply(fetchResult)
+			if err != nil {
+				return rowsSent, wraperror.Errorf(err, "Apply")
+			}
+
+			if !isKept {
+				continue
+			}
+		}
+
+		response := &szpb.StreamEntityReportResponse{
+			Sequence: sequence,
+			Result:   fetchResult,
+		}
+
+		checkpointPosition := sequence
+		if filter != nil {
+			checkpointPosition = rowsSent
+		}
+
+		if (checkpointPosition+1)%checkpointInterval == 0 {
+			response.ResumeToken = server.encodeResumeToken(resumeToken{
+				entityReport: report,
+				Expires:      time.Now().Add(server.getResumeTokenTTL()).UnixMilli(),
+				Sequence:     sequence,
+			})
+		}
+
+		err = send(response)
+		if err != nil {
+			return rowsSent, wraperror.Errorf(err, "stream.Send")
+		}
+
+		rowsSent++
+	}
+}
+
+// Sign token and encode it as "payload.signature" in unpadded base64url.
+func (server *SzExportServer) encodeResumeToken(token resumeToken) string {
+	payload, err := json.Marshal(token)
+	if err != nil {
+		panic(err)
+	}
+
+	encoder := base64.RawURLEncoding
+
+	return encoder.EncodeToString(payload) + "." + encoder.EncodeToString(server.sign(payload))
+}
+
+// Check the signature, expiry and export of a token from encodeResumeToken.
+func (server *SzExportServer) decodeResumeToken(encodedToken string, report entityReport) (resumeToken, error) {
+	var token resumeToken
+
+	encoder := base64.RawURLEncoding
+	encodedPayload, encodedSignature, _ := strings.Cut(encodedToken, ".")
+
+	payload, err := encoder.DecodeString(encodedPayload)
+	if err != nil {
+		return token, status.Error(codes.InvalidArgument, "resume token is malformed")
+	}
+
+	signature, err := encoder.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, server.sign(payload)) {
+		return token, status.Error(codes.InvalidArgument, "resume token was not issued by this server")
+	}
+
+	err = json.Unmarshal(payload, &token)
+	if err != nil {
+		return token, status.Error(codes.InvalidArgument, "resume token is malformed")
+	}
+
+	if token.entityReport != report {
+		return token, status.Error(codes.InvalidArgument, "resume token belongs to an export with other flags or columns")
+	}
+
+	if time.Now().UnixMilli() > token.Expires {
+		return token, status.Error(codes.FailedPrecondition, "resume token has expired; restart the export")
+	}
+
+	return token, nil
+}
+
+func (server *SzExportServer) getCheckpointInterval() int64 {
+	if server.CheckpointInterval > 0 {
+		return server.CheckpointInterval
+	}
+
+	return DefaultCheckpointInterval
+}
+
+func (server *SzExportServer) getResumeTokenTTL() time.Duration {
+	if server.ResumeTokenTTL > 0 {
+		return server.ResumeTokenTTL
+	}
+
+	return DefaultResumeTokenTTL
+}
+
+// HMAC-SHA256 of payload with ResumeTokenKey, or with a random key if it is not set.
+func (server *SzExportServer) sign(payload []byte) []byte {
+	server.tokenKeyOnce.Do(func() {
+		server.tokenKey = server.ResumeTokenKey
+		if len(server.tokenKey) == 0 {
+			server.tokenKey = make([]byte, sha256.Size)
+			_, _ = rand.Read(server.tokenKey)
+		}
+	})
+
+	mac := hmac.New(sha256.New, server.tokenKey)
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
+
+// --- Logging ----------------------------------------------------------------
+
+// Get the Logger singleton.
+func (server *SzExportServer) getLogger() logging.Logging {
+	var err error
+
+	if server.logger == nil {
+		options := []interface{}{
+			&logging.OptionCallerSkip{Value: OptionCallerSkip},
+		}
+
+		server.logger, err = logging.NewSenzingLogger(ComponentID, IDMessages, options...)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return server.logger
+}
+
+// Trace method entry.
+func (server *SzExportServer) traceEntry(messageNumber int, details ...interface{}) {
+	server.getLogger().Log(messageNumber, details...)
+}
+
+// Trace method exit.
+func (server *SzExportServer) traceExit(messageNumber int, details ...interface{}) {
+	server.getLogger().Log(messageNumber, details...)
+}
+
+func (server *SzExportServer) SetLogLevel(ctx context.Context, logLevelName string) error {
+	_ = ctx
+
+	var err error
+
+	if server.isTrace {
+		entryTime := time.Now()
+
+		server.traceEntry(5, logLevelName)
+
+		defer func() { server.traceExit(6, logLevelName, err, time.Since(entryTime)) }()
+	}
+
+	if !logging.IsValidLogLevelName(logLevelName) {
+		return wraperror.Errorf(errPackage, "invalid error level: %s", logLevelName)
+	}
+
+	err = server.getLogger().SetLogLevel(logLevelName)
+	if err != nil {
+		return wraperror.Errorf(err, "SetLogLevel: %s", logLevelName)
+	}
+
+	server.isTrace = (logLevelName == logging.LevelTraceName)
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+/*
+Get the filter of a request, or nil if it has neither a filter nor fields.
+Invalid filters are reported as InvalidArgument.
+*/
+func newEntityReportFilter(request *szpb.StreamJsonEntityReportRequest) (*szengineserver.EntityReportFilter, error) {
+	if request.GetFilter() == nil && len(request.GetFields()) == 0 {
+		return nil, nil //nolint:nilnil // No filter.
+	}
+
+	result := &szengineserver.EntityReportFilter{
+		DataSources:    request.GetFilter().GetDataSources(),
+		Fields:         request.GetFields(),
+		MaxEntityID:    request.GetFilter().GetMaxEntityId(),
+		MinEntityID:    request.GetFilter().GetMinEntityId(),
+		MinRecordCount: request.GetFilter().GetMinRecordCount(),
+	}
+
+	err := result.Validate()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return result, nil
+}
+
+// A short digest of filter, so that a resume token only resumes an export with the same filter.
+func filterDigest(filter *szengineserver.EntityReportFilter) string {
+	if filter == nil {
+		return ""
+	}
+
+	canonical, err := json.Marshal(filter)
+	if err != nil {
+		panic(err)
+	}
+
+	digest := sha256.Sum256(canonical)
+
+	return base64.RawURLEncoding.EncodeToString(digest[:filterDigestSize])
+}
@@ -0,0 +1,68 @@
+package szexportserver_test
+
+import (
+	"context"
+	"testing"
+
+	szpb "github.com/senzing-garage/serve-grpc/proto/go/szexport"
+	"github.com/senzing-garage/serve-grpc/szexportserver"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testStream is a server stream that records the responses sent on it.
+type testStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*szpb.StreamEntityReportResponse
+}
+
+func (stream *testStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *testStream) Send(response *szpb.StreamEntityReportResponse) error {
+	stream.responses = append(stream.responses, response)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSzExport_StreamJsonEntityReport_badResumeToken(test *testing.T) {
+	testObject := &szexportserver.SzExportServer{}
+
+	for _, resumeToken := range []string{"not-base64!", "e30.c2lnbmF0dXJl", "e30"} {
+		stream := &testStream{ctx: test.Context()}
+		err := testObject.StreamJsonEntityReport(&szpb.StreamJsonEntityReportRequest{ResumeToken: resumeToken}, stream)
+		require.Equal(test, codes.InvalidArgument, status.Code(err), resumeToken)
+		require.Empty(test, stream.responses)
+	}
+}
+
+func TestSzExport_StreamJsonEntityReport_badFields(test *testing.T) {
+	testObject := &szexportserver.SzExportServer{}
+	stream := &testStream{ctx: test.Context()}
+	request := &szpb.StreamJsonEntityReportRequest{Fields: []string{"RESOLVED_ENTITY.RECORDS[0]"}}
+	err := testObject.StreamJsonEntityReport(request, stream)
+	require.Equal(test, codes.InvalidArgument, status.Code(err))
+	require.Empty(test, stream.responses)
+}
+
+func TestSzExport_SetLogLevel(test *testing.T) {
+	ctx := test.Context()
+	testObject := &szexportserver.SzExportServer{}
+	err := testObject.SetLogLevel(ctx, "DEBUG")
+	require.NoError(test, err)
+}
+
+func TestSzExport_SetLogLevel_badLevelName(test *testing.T) {
+	ctx := test.Context()
+	testObject := &szexportserver.SzExportServer{}
+	err := testObject.SetLogLevel(ctx, "BADLEVELNAME")
+	require.Error(test, err)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/senzing-garage/go-helpers/wraperror"
 	"github.com/senzing-garage/go-logging/logging"
 	"github.com/senzing-garage/go-observing/observer"
+	"github.com/senzing-garage/serve-grpc/tracing"
 	szsdk "github.com/senzing-garage/sz-sdk-go-core/szproduct"
 	"github.com/senzing-garage/sz-sdk-go/senzing"
 	szpb "github.com/senzing-garage/sz-sdk-proto/go/szproduct"
@@ -40,6 +41,9 @@ func (server *SzProductServer) GetLicense(
 		defer func() { server.traceExit(12, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzProduct.GetLicense", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szProduct := getSzProduct()
 	result, err = szProduct.GetLicense(ctx)
 	response := szpb.GetLicenseResponse{
@@ -65,6 +69,9 @@ func (server *SzProductServer) GetVersion(
 		defer func() { server.traceExit(20, request, result, err, time.Since(entryTime)) }()
 	}
 
+	ctx, span := tracing.StartSdkSpan(ctx, "SzProduct.GetVersion", request)
+	defer func() { tracing.EndSdkSpan(span, err) }()
+
 	szProduct := getSzProduct()
 	result, err = szProduct.GetVersion(ctx)
 	response := szpb.GetVersionResponse{
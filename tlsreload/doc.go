@@ -0,0 +1,5 @@
+/*
+Package tlsreload keeps the server certificate and client certificate authorities of TLS
+listeners up to date with their files, so rotated certificates are used without a restart.
+*/
+package tlsreload
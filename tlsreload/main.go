@@ -0,0 +1,34 @@
+package tlsreload
+
+import (
+	"errors"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Identfier of the tlsreload package found messages having the format "senzing-6020xxxx".
+const ComponentID = 6020
+
+// Log message prefix.
+const Prefix = "serve-grpc.tlsreload."
+
+// DefaultInterval is how often Watch looks for changed files when no interval is given.
+const DefaultInterval = 10 * time.Second
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Message templates for the tlsreload package.
+var IDMessages = map[int]string{
+	2001: "Reloaded TLS certificate %s.",
+	3001: "Reloading TLS certificate %s failed; still using the previous one: %v",
+}
+
+// Status strings for specific tlsreload messages.
+var IDStatuses = map[int]string{}
+
+var errPackage = errors.New("tlsreload")
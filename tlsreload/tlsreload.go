@@ -0,0 +1,252 @@
+package tlsreload
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"maps"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	tlshelper "github.com/senzing-garage/go-helpers/tls"
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"github.com/senzing-garage/go-logging/logging"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+/*
+A Reloader holds the TLS configuration built from a server certificate, its key and,
+for mutual TLS, the certificate authorities of the client certificates.  Listeners
+configured with TLSConfig use the latest configuration for every new connection.
+*/
+type Reloader struct {
+	CertificateFile string
+	ClientCAFiles   []string // Client certificates are required and verified against these CAs; none means no client certificates.
+	KeyFile         string
+	KeyPassPhrase   string // Decrypts KeyFile; empty if KeyFile is not encrypted.
+	config          atomic.Pointer[tls.Config]
+	fileStates      map[string]fileState
+	logger          logging.Logging
+	mutex           sync.Mutex
+}
+
+// fileState is what Watch compares to notice a changed file.
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const OptionCallerSkip = 3
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewReloader function loads the TLS files.
+
+Input
+  - ctx: A context to control lifecycle.
+  - certificateFile: Path of the PEM server certificate.
+  - keyFile: Path of the PEM private key of the server certificate.
+  - keyPassPhrase: Pass phrase of an encrypted keyFile, else empty.
+  - clientCAFiles: Paths of PEM client certificate authorities.  None disables mutual TLS.
+
+Output
+  - A Reloader holding the loaded configuration.
+*/
+func NewReloader(
+	ctx context.Context,
+	certificateFile string,
+	keyFile string,
+	keyPassPhrase string,
+	clientCAFiles []string,
+) (*Reloader, error) {
+	result := &Reloader{
+		CertificateFile: certificateFile,
+		ClientCAFiles:   clientCAFiles,
+		KeyFile:         keyFile,
+		KeyPassPhrase:   keyPassPhrase,
+	}
+
+	err := result.Reload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+/*
+The Reload method reads the TLS files again.  If they cannot be read the previous
+configuration stays in use.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - Nothing is returned, except for an error.
+*/
+func (reloader *Reloader) Reload(ctx context.Context) error {
+	reloader.mutex.Lock()
+	defer reloader.mutex.Unlock()
+
+	return reloader.reload(ctx)
+}
+
+/*
+The TLSConfig method gets a tls.Config for a listener.  Each handshake uses the
+configuration last loaded, and negotiates HTTP/2 when the client supports it.
+
+Output
+  - The tls.Config.
+*/
+func (reloader *Reloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return &reloader.config.Load().Certificates[0], nil
+		},
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return reloader.config.Load(), nil
+		},
+		MinVersion: tls.VersionTLS12, // See https://pkg.go.dev/crypto/tls#pkg-constants
+		MaxVersion: tls.VersionTLS13,
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+}
+
+/*
+The Watch method reloads the TLS files whenever one of them changes, until ctx is
+done.  Files are compared by modification time and size every interval, which also
+notices the symbolic link swaps used to update Kubernetes secrets.
+
+Input
+  - ctx: A context to control lifecycle.
+  - interval: Time between checks; zero or less uses DefaultInterval.
+*/
+func (reloader *Reloader) Watch(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloader.reloadIfChanged(ctx)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (reloader *Reloader) reloadIfChanged(ctx context.Context) {
+	reloader.mutex.Lock()
+	defer reloader.mutex.Unlock()
+
+	if maps.Equal(reloader.fileStates, reloader.statFiles()) {
+		return
+	}
+
+	err := reloader.reload(ctx)
+	if err != nil {
+		reloader.getLogger().Log(3001, reloader.CertificateFile, err)
+
+		return
+	}
+
+	reloader.getLogger().Log(2001, reloader.CertificateFile)
+}
+
+/*
+Load the files.  Their states are recorded even if loading fails, so that a broken
+file is reported once and loaded again when it next changes.
+*/
+func (reloader *Reloader) reload(ctx context.Context) error {
+	reloader.fileStates = reloader.statFiles()
+
+	certificate, err := tlshelper.LoadX509KeyPair(ctx, reloader.CertificateFile, reloader.KeyFile, reloader.KeyPassPhrase)
+	if err != nil {
+		return wraperror.Errorf(err, "LoadX509KeyPair")
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientAuth:   tls.NoClientCert,
+		MinVersion:   tls.VersionTLS12, // See https://pkg.go.dev/crypto/tls#pkg-constants
+		MaxVersion:   tls.VersionTLS13,
+		NextProtos:   []string{"h2", "http/1.1"},
+	}
+
+	if len(reloader.ClientCAFiles) > 0 {
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+		config.ClientCAs = x509.NewCertPool()
+
+		for _, clientCAFile := range reloader.ClientCAFiles {
+			pemClientCA, err := os.ReadFile(clientCAFile)
+			if err != nil {
+				return wraperror.Errorf(err, "os.ReadFile %s", clientCAFile)
+			}
+
+			if !config.ClientCAs.AppendCertsFromPEM(pemClientCA) {
+				return wraperror.Errorf(errPackage, "failed to add client CA's certificate for %s", clientCAFile)
+			}
+		}
+	}
+
+	reloader.config.Store(config)
+
+	return nil
+}
+
+func (reloader *Reloader) statFiles() map[string]fileState {
+	result := map[string]fileState{}
+
+	for _, filename := range append([]string{reloader.CertificateFile, reloader.KeyFile}, reloader.ClientCAFiles...) {
+		fileInfo, err := os.Stat(filename)
+		if err == nil {
+			result[filename] = fileState{modTime: fileInfo.ModTime(), size: fileInfo.Size()}
+		}
+	}
+
+	return result
+}
+
+// --- Logging ----------------------------------------------------------------
+
+// Get the Logger singleton.
+func (reloader *Reloader) getLogger() logging.Logging {
+	var err error
+
+	if reloader.logger == nil {
+		options := []interface{}{
+			logging.OptionCallerSkip{Value: OptionCallerSkip},
+		}
+
+		reloader.logger, err = logging.NewSenzingLogger(ComponentID, IDMessages, options...)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return reloader.logger
+}
@@ -0,0 +1,213 @@
+package tlsreload_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/serve-grpc/tlsreload"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testCertificateAuthority = "../testdata/certificates/certificate-authority/certificate.pem"
+	testCertificatesPath     = "../testdata/certificates"
+	testKeyPassPhrase        = "Passw0rd"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestNewReloader(test *testing.T) {
+	ctx := test.Context()
+	reloader, err := tlsreload.NewReloader(
+		ctx,
+		filepath.Join(testCertificatesPath, "server", "certificate.pem"),
+		filepath.Join(testCertificatesPath, "server", "private_key.pem"),
+		"",
+		nil,
+	)
+	require.NoError(test, err)
+
+	config := getConfigForClient(test, reloader)
+	require.Equal(test, tls.NoClientCert, config.ClientAuth)
+	require.Equal(test, []string{"h2", "http/1.1"}, config.NextProtos)
+
+	// An encrypted key with client certificate authorities.
+
+	reloader, err = tlsreload.NewReloader(
+		ctx,
+		filepath.Join(testCertificatesPath, "server", "certificate.pem"),
+		filepath.Join(testCertificatesPath, "server", "private_key_encrypted.pem"),
+		testKeyPassPhrase,
+		[]string{testCertificateAuthority},
+	)
+	require.NoError(test, err)
+
+	config = getConfigForClient(test, reloader)
+	require.Equal(test, tls.RequireAndVerifyClientCert, config.ClientAuth)
+	require.NotNil(test, config.ClientCAs)
+}
+
+func TestNewReloader_badFiles(test *testing.T) {
+	ctx := test.Context()
+	certificateFile := filepath.Join(testCertificatesPath, "server", "certificate.pem")
+	keyFile := filepath.Join(testCertificatesPath, "server", "private_key.pem")
+
+	_, err := tlsreload.NewReloader(ctx, filepath.Join(test.TempDir(), "missing.pem"), keyFile, "", nil)
+	require.Error(test, err)
+
+	_, err = tlsreload.NewReloader(ctx, certificateFile, keyFile, "", []string{filepath.Join(test.TempDir(), "missing.pem")})
+	require.Error(test, err)
+
+	_, err = tlsreload.NewReloader(ctx, certificateFile, keyFile, "", []string{keyFile})
+	require.Error(test, err)
+}
+
+func TestReloader_TLSConfig(test *testing.T) {
+	ctx := test.Context()
+	directory := copyCertificate(test, "server")
+	reloader, err := tlsreload.NewReloader(
+		ctx,
+		filepath.Join(directory, "certificate.pem"),
+		filepath.Join(directory, "private_key.pem"),
+		"",
+		nil,
+	)
+	require.NoError(test, err)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", reloader.TLSConfig())
+	require.NoError(test, err)
+
+	defer listener.Close()
+
+	go func() {
+		for {
+			connection, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			_ = connection.(*tls.Conn).HandshakeContext(ctx)
+			_ = connection.Close()
+		}
+	}()
+
+	// Clients negotiate HTTP/2 over the loaded certificate.
+
+	connectionState := dial(test, listener.Addr().String())
+	require.Equal(test, "h2", connectionState.NegotiatedProtocol)
+	require.Equal(test, "Test Server", connectionState.PeerCertificates[0].Subject.OrganizationalUnit[0])
+
+	// Later connections use the reloaded certificate.
+
+	replaceCertificate(test, directory, "client")
+	require.NoError(test, reloader.Reload(ctx))
+
+	connectionState = dial(test, listener.Addr().String())
+	require.Equal(test, "Test Client", connectionState.PeerCertificates[0].Subject.OrganizationalUnit[0])
+}
+
+func TestReloader_Watch(test *testing.T) {
+	ctx := test.Context()
+	directory := copyCertificate(test, "server")
+	reloader, err := tlsreload.NewReloader(
+		ctx,
+		filepath.Join(directory, "certificate.pem"),
+		filepath.Join(directory, "private_key.pem"),
+		"",
+		nil,
+	)
+	require.NoError(test, err)
+
+	go reloader.Watch(ctx, 10*time.Millisecond)
+
+	replaceCertificate(test, directory, "client")
+	require.Eventually(test, func() bool {
+		return servedOrganizationalUnit(test, reloader) == "Test Client"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// A broken certificate is reported, and the previous one stays in use.
+
+	later := time.Now().Add(2 * time.Hour)
+	require.NoError(test, os.WriteFile(filepath.Join(directory, "certificate.pem"), []byte("broken"), 0o600))
+	require.NoError(test, os.Chtimes(filepath.Join(directory, "certificate.pem"), later, later))
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(test, "Test Client", servedOrganizationalUnit(test, reloader))
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Copy the certificate and key of a testdata identity into a temporary directory.
+func copyCertificate(test *testing.T, identity string) string {
+	test.Helper()
+
+	directory := test.TempDir()
+	for _, filename := range []string{"certificate.pem", "private_key.pem"} {
+		contents, err := os.ReadFile(filepath.Join(testCertificatesPath, identity, filename))
+		require.NoError(test, err)
+		require.NoError(test, os.WriteFile(filepath.Join(directory, filename), contents, 0o600))
+	}
+
+	return directory
+}
+
+func dial(test *testing.T, address string) tls.ConnectionState {
+	test.Helper()
+
+	pemCertificateAuthority, err := os.ReadFile(testCertificateAuthority)
+	require.NoError(test, err)
+
+	rootCAs := x509.NewCertPool()
+	require.True(test, rootCAs.AppendCertsFromPEM(pemCertificateAuthority))
+
+	connection, err := tls.Dial("tcp", address, &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{"h2", "http/1.1"},
+		RootCAs:    rootCAs,
+		ServerName: "localhost",
+	})
+	require.NoError(test, err)
+
+	defer connection.Close()
+
+	return connection.ConnectionState()
+}
+
+func getConfigForClient(test *testing.T, reloader *tlsreload.Reloader) *tls.Config {
+	test.Helper()
+
+	config, err := reloader.TLSConfig().GetConfigForClient(&tls.ClientHelloInfo{})
+	require.NoError(test, err)
+
+	return config
+}
+
+// Overwrite the files in directory with another identity's, dated later so the change is noticed.
+func replaceCertificate(test *testing.T, directory string, identity string) {
+	test.Helper()
+
+	later := time.Now().Add(time.Hour)
+	for _, filename := range []string{"certificate.pem", "private_key.pem"} {
+		contents, err := os.ReadFile(filepath.Join(testCertificatesPath, identity, filename))
+		require.NoError(test, err)
+		require.NoError(test, os.WriteFile(filepath.Join(directory, filename), contents, 0o600))
+		require.NoError(test, os.Chtimes(filepath.Join(directory, filename), later, later))
+	}
+}
+
+func servedOrganizationalUnit(test *testing.T, reloader *tlsreload.Reloader) string {
+	test.Helper()
+
+	config := getConfigForClient(test, reloader)
+	certificate, err := x509.ParseCertificate(config.Certificates[0].Certificate[0])
+	require.NoError(test, err)
+
+	return certificate.Subject.OrganizationalUnit[0]
+}
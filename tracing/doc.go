@@ -0,0 +1,4 @@
+/*
+Package tracing creates OpenTelemetry spans for the Senzing SDK calls made by the gRPC handlers.
+*/
+package tracing
@@ -0,0 +1,140 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// InstrumentationName is the name of the tracer used for Senzing SDK spans.
+const InstrumentationName = "github.com/senzing-garage/serve-grpc"
+
+// Span attribute keys for values taken from gRPC requests.
+const (
+	DataSourceCodeKey  = attribute.Key("senzing.data_source_code")
+	DataSourceCode1Key = attribute.Key("senzing.data_source_code_1")
+	DataSourceCode2Key = attribute.Key("senzing.data_source_code_2")
+	EntityIDKey        = attribute.Key("senzing.entity_id")
+	EntityID1Key       = attribute.Key("senzing.entity_id_1")
+	EntityID2Key       = attribute.Key("senzing.entity_id_2")
+	FlagsKey           = attribute.Key("senzing.flags")
+	RecordIDKey        = attribute.Key("senzing.record_id")
+	RecordID1Key       = attribute.Key("senzing.record_id_1")
+	RecordID2Key       = attribute.Key("senzing.record_id_2")
+)
+
+// ----------------------------------------------------------------------------
+// Public functions
+// ----------------------------------------------------------------------------
+
+/*
+The EndSdkSpan function ends a span created by StartSdkSpan, recording err if not nil.
+
+Input
+  - span: The span returned by StartSdkSpan.
+  - err: The error returned by the Senzing SDK call, or nil.
+*/
+func EndSdkSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+/*
+The Propagator function returns the propagator used to read trace context from incoming
+gRPC metadata and grpc-web headers: W3C trace-context and W3C baggage.
+
+Output
+  - A propagation.TextMapPropagator.
+*/
+func Propagator() propagation.TextMapPropagator {
+	return propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+}
+
+/*
+The StartSdkSpan function starts a child span for a Senzing SDK call.
+
+The span is created by the TracerProvider of the span already in ctx (normally the
+gRPC server span), so no global TracerProvider is needed.  Data source, record id,
+entity id and flags found in the gRPC request are added as attributes.
+
+Input
+  - ctx: A context holding the parent span.
+  - spanName: The Senzing SDK method, e.g. "SzEngine.AddRecord".
+  - request: The gRPC request message.
+
+Output
+  - A context holding the new span.
+  - The new span.  End it with EndSdkSpan.
+*/
+func StartSdkSpan(ctx context.Context, spanName string, request any) (context.Context, trace.Span) {
+	tracer := trace.SpanFromContext(ctx).TracerProvider().Tracer(InstrumentationName)
+
+	return tracer.Start(
+		ctx,
+		spanName,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(requestAttributes(request)...),
+	)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Extract the attributes from the getters generated for the request's protobuf fields.
+func requestAttributes(request any) []attribute.KeyValue {
+	var result []attribute.KeyValue
+
+	if getter, ok := request.(interface{ GetDataSourceCode() string }); ok {
+		result = append(result, DataSourceCodeKey.String(getter.GetDataSourceCode()))
+	}
+
+	if getter, ok := request.(interface{ GetDataSourceCode_1() string }); ok {
+		result = append(result, DataSourceCode1Key.String(getter.GetDataSourceCode_1()))
+	}
+
+	if getter, ok := request.(interface{ GetDataSourceCode_2() string }); ok {
+		result = append(result, DataSourceCode2Key.String(getter.GetDataSourceCode_2()))
+	}
+
+	if getter, ok := request.(interface{ GetRecordId() string }); ok {
+		result = append(result, RecordIDKey.String(getter.GetRecordId()))
+	}
+
+	if getter, ok := request.(interface{ GetRecordId_1() string }); ok {
+		result = append(result, RecordID1Key.String(getter.GetRecordId_1()))
+	}
+
+	if getter, ok := request.(interface{ GetRecordId_2() string }); ok {
+		result = append(result, RecordID2Key.String(getter.GetRecordId_2()))
+	}
+
+	if getter, ok := request.(interface{ GetEntityId() int64 }); ok {
+		result = append(result, EntityIDKey.Int64(getter.GetEntityId()))
+	}
+
+	if getter, ok := request.(interface{ GetEntityId_1() int64 }); ok {
+		result = append(result, EntityID1Key.Int64(getter.GetEntityId_1()))
+	}
+
+	if getter, ok := request.(interface{ GetEntityId_2() int64 }); ok {
+		result = append(result, EntityID2Key.Int64(getter.GetEntityId_2()))
+	}
+
+	if getter, ok := request.(interface{ GetFlags() int64 }); ok {
+		result = append(result, FlagsKey.Int64(getter.GetFlags()))
+	}
+
+	return result
+}
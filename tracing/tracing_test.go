@@ -0,0 +1,77 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/senzing-garage/serve-grpc/tracing"
+	szpb "github.com/senzing-garage/sz-sdk-proto/go/szengine"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var errTest = errors.New("test error")
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestStartSdkSpan(test *testing.T) {
+	ctx := test.Context()
+	spanRecorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder))
+	parentCtx, parentSpan := tracerProvider.Tracer("test").Start(ctx, "parent")
+	request := &szpb.AddRecordRequest{
+		DataSourceCode: "CUSTOMERS",
+		RecordId:       "1001",
+		Flags:          8,
+	}
+
+	spanCtx, span := tracing.StartSdkSpan(parentCtx, "SzEngine.AddRecord", request)
+	require.NotEqual(test, parentCtx, spanCtx)
+	tracing.EndSdkSpan(span, nil)
+	parentSpan.End()
+
+	ended := spanRecorder.Ended()
+	require.Len(test, ended, 2)
+	require.Equal(test, "SzEngine.AddRecord", ended[0].Name())
+	require.Equal(test, parentSpan.SpanContext().SpanID(), ended[0].Parent().SpanID())
+	require.Equal(test, codes.Unset, ended[0].Status().Code)
+	require.ElementsMatch(test, []attribute.KeyValue{
+		tracing.DataSourceCodeKey.String("CUSTOMERS"),
+		tracing.RecordIDKey.String("1001"),
+		tracing.FlagsKey.Int64(8),
+	}, ended[0].Attributes())
+}
+
+func TestStartSdkSpan_noParent(test *testing.T) {
+	ctx := context.Background()
+	_, span := tracing.StartSdkSpan(ctx, "SzEngine.GetStats", &szpb.GetStatsRequest{})
+	require.False(test, span.IsRecording())
+	tracing.EndSdkSpan(span, errTest)
+}
+
+func TestEndSdkSpan_error(test *testing.T) {
+	ctx := test.Context()
+	spanRecorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder))
+	parentCtx, parentSpan := tracerProvider.Tracer("test").Start(ctx, "parent")
+
+	_, span := tracing.StartSdkSpan(parentCtx, "SzEngine.WhyEntities", &szpb.WhyEntitiesRequest{
+		EntityId_1: 1,
+		EntityId_2: 2,
+	})
+	tracing.EndSdkSpan(span, errTest)
+	parentSpan.End()
+
+	ended := spanRecorder.Ended()
+	require.Len(test, ended, 2)
+	require.Equal(test, codes.Error, ended[0].Status().Code)
+	require.Equal(test, errTest.Error(), ended[0].Status().Description)
+	require.Contains(test, ended[0].Attributes(), tracing.EntityID1Key.Int64(1))
+	require.Contains(test, ended[0].Attributes(), tracing.EntityID2Key.Int64(2))
+}